@@ -0,0 +1,9 @@
+data "unkey_api" "payments" {
+  id = "api_1234567890abcdef"
+}
+
+resource "unkey_key" "payments" {
+  api_id      = data.unkey_api.payments.id
+  name        = "payments-key"
+  byte_length = 16
+}
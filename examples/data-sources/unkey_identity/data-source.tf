@@ -0,0 +1,11 @@
+data "unkey_identity" "acme" {
+  external_id = "acme-corp"
+  api_id      = "api_1234567890abcdef"
+}
+
+resource "unkey_key" "acme_ci" {
+  api_id      = data.unkey_identity.acme.api_id
+  name        = "acme-ci"
+  byte_length = 16
+  external_id = data.unkey_identity.acme.external_id
+}
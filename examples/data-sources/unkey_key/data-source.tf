@@ -0,0 +1,11 @@
+data "unkey_key" "billing" {
+  id = "key_1234567890abcdef"
+}
+
+output "billing_key_enabled" {
+  value = data.unkey_key.billing.enabled
+}
+
+output "billing_key_remaining_credits" {
+  value = data.unkey_key.billing.credits.remaining
+}
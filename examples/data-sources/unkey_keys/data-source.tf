@@ -0,0 +1,9 @@
+data "unkey_keys" "acme" {
+  api_id      = "api_1234567890abcdef"
+  external_id = "acme-corp"
+  prefix      = "prod"
+}
+
+output "acme_disabled_keys" {
+  value = [for key in data.unkey_keys.acme.keys : key.id if !key.enabled]
+}
@@ -0,0 +1,10 @@
+data "unkey_permission" "documents_read" {
+  slug = "documents.read"
+}
+
+resource "unkey_key" "reader" {
+  api_id      = "api_1234567890abcdef"
+  name        = "reader-key"
+  byte_length = 16
+  permissions = [data.unkey_permission.documents_read.slug]
+}
@@ -0,0 +1,15 @@
+data "unkey_permissions" "documents" {
+  slug_prefix = "documents."
+}
+
+locals {
+  requested_permissions = ["documents.read", "documents.write"]
+  known_permissions     = [for permission in data.unkey_permissions.documents.permissions : permission.slug]
+}
+
+check "permissions_exist" {
+  assert {
+    condition     = length(setsubtract(local.requested_permissions, local.known_permissions)) == 0
+    error_message = "Every requested permission must exist in the Unkey permission catalog."
+  }
+}
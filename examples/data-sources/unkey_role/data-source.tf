@@ -0,0 +1,10 @@
+data "unkey_role" "billing_admin" {
+  name = "admin.billing"
+}
+
+resource "unkey_key" "billing" {
+  api_id      = "api_1234567890abcdef"
+  name        = "billing-key"
+  byte_length = 16
+  roles       = [data.unkey_role.billing_admin.name]
+}
@@ -0,0 +1,7 @@
+data "unkey_roles" "admin" {
+  name_prefix = "admin."
+}
+
+output "admin_roles" {
+  value = [for role in data.unkey_roles.admin.roles : role.name]
+}
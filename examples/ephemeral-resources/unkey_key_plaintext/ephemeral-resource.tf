@@ -0,0 +1,15 @@
+resource "unkey_key" "billing" {
+  api_id      = "api_1234567890abcdef"
+  byte_length = 16
+  recoverable = true
+}
+
+ephemeral "unkey_key_plaintext" "billing" {
+  id = unkey_key.billing.id
+}
+
+resource "aws_secretsmanager_secret_version" "billing" {
+  secret_id                = "billing-api-key"
+  secret_string_wo         = ephemeral.unkey_key_plaintext.billing.key
+  secret_string_wo_version = 1
+}
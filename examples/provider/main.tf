@@ -13,6 +13,13 @@ provider "unkey" {
   root_key = "unkey_XXX"
 }
 
+# A second workspace, with the root key read from the [staging] profile of
+# ~/.unkey/credentials. Resources select it with provider = unkey.staging.
+provider "unkey" {
+  alias   = "staging"
+  profile = "staging"
+}
+
 resource "unkey_api" "demo" {
   name = "demo-api"
 }
@@ -28,7 +35,8 @@ resource "unkey_permission" "read_documents" {
   description = "Permission to read documents"
 }
 
-# Missing a way to link permissions to roles via terraform
+# Unkey has no API to link permissions to roles, so assign them in the
+# dashboard. unkey_role.role_admin.permissions reports what is assigned.
 
 resource "unkey_key" "admin_key" {
   api_id      = unkey_api.demo.id
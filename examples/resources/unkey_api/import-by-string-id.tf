@@ -0,0 +1,4 @@
+import {
+  to = unkey_api.example
+  id = "api_1234567890abcdef"
+}
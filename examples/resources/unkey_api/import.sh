@@ -0,0 +1,2 @@
+# An API can be imported by its ID.
+terraform import unkey_api.example api_1234567890abcdef
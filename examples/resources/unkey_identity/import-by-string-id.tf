@@ -0,0 +1,4 @@
+import {
+  to = unkey_identity.example
+  id = "user_123"
+}
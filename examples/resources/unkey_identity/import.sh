@@ -0,0 +1,3 @@
+# An identity can be imported by its ID or by its external ID.
+terraform import unkey_identity.example id_1234567890abcdef
+terraform import unkey_identity.example user_123
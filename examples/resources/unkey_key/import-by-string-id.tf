@@ -0,0 +1,4 @@
+import {
+  to = unkey_key.example
+  id = "api_1234567890abcdef/key_1234567890abcdef"
+}
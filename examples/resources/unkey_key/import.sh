@@ -0,0 +1,8 @@
+# A key can be imported by its ID. Unkey does not report which API a key
+# belongs to, so prefer <api_id>/<key_id> to populate api_id as well.
+#
+# Unkey does not report byte_length or permanent_deletion either, so they are
+# read as their defaults. The prefix is taken from the start of the key, and
+# recoverable is found by decrypting the key when the root key may do so. The
+# key itself is only returned on creation, so key stays null.
+terraform import unkey_key.example api_1234567890abcdef/key_1234567890abcdef
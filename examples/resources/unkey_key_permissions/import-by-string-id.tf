@@ -0,0 +1,4 @@
+import {
+  to = unkey_key_permissions.example
+  id = "key_1234567890abcdef"
+}
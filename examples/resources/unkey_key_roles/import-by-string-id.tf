@@ -0,0 +1,4 @@
+import {
+  to = unkey_key_roles.example
+  id = "key_1234567890abcdef"
+}
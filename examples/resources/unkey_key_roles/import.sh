@@ -0,0 +1,3 @@
+# The roles of a key can be imported by the key ID. Imported roles are tracked
+# authoritatively, so switching to additive mode on the next apply revokes none.
+terraform import unkey_key_roles.example key_1234567890abcdef
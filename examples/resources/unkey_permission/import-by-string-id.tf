@@ -0,0 +1,4 @@
+import {
+  to = unkey_permission.example
+  id = "read.documents"
+}
@@ -0,0 +1,3 @@
+# A permission can be imported by its ID, its slug or its name.
+terraform import unkey_permission.example perm_1234567890abcdef
+terraform import unkey_permission.example read.documents
@@ -0,0 +1,4 @@
+import {
+  to = unkey_role.example
+  id = "admin-role"
+}
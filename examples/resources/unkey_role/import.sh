@@ -0,0 +1,3 @@
+# A role can be imported by its ID or by its name.
+terraform import unkey_role.example role_1234567890abcdef
+terraform import unkey_role.example admin-role
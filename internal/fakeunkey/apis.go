@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+
+package fakeunkey
+
+import (
+	"github.com/unkeyed/sdks/api/go/v2/models/components"
+)
+
+func (s *Server) createAPI(req components.V2ApisCreateAPIRequestBody) (any, *apiError) {
+	if req.Name == "" {
+		return nil, badRequest("name is required")
+	}
+
+	api := &api{id: s.newID("api"), name: req.Name}
+	s.apis[api.id] = api
+
+	return components.V2ApisCreateAPIResponseData{APIID: api.id}, nil
+}
+
+func (s *Server) getAPI(req components.V2ApisGetAPIRequestBody) (any, *apiError) {
+	api, ok := s.apis[req.APIID]
+	if !ok {
+		return nil, notFound("api %s does not exist", req.APIID)
+	}
+
+	return components.V2ApisGetAPIResponseData{ID: api.id, Name: api.name}, nil
+}
+
+func (s *Server) deleteAPI(req components.V2ApisDeleteAPIRequestBody) (any, *apiError) {
+	if !s.removeAPI(req.APIID) {
+		return nil, notFound("api %s does not exist", req.APIID)
+	}
+
+	return components.EmptyResponse{}, nil
+}
+
+// removeAPI deletes an API together with its keys.
+func (s *Server) removeAPI(id string) bool {
+	if _, ok := s.apis[id]; !ok {
+		return false
+	}
+
+	for keyID, key := range s.keys {
+		if key.apiID == id {
+			delete(s.keys, keyID)
+		}
+	}
+	delete(s.apis, id)
+
+	return true
+}
+
+func (s *Server) listKeys(req components.V2ApisListKeysRequestBody) (any, components.Pagination, *apiError) {
+	if _, ok := s.apis[req.APIID]; !ok {
+		return nil, components.Pagination{}, notFound("api %s does not exist", req.APIID)
+	}
+
+	keys := []components.KeyResponseData{}
+	for _, id := range sortedIDs(s.keys) {
+		key := s.keys[id]
+		if key.apiID != req.APIID {
+			continue
+		}
+		if req.ExternalID != nil && (key.identityID == "" || s.identities[key.identityID].data.ExternalID != *req.ExternalID) {
+			continue
+		}
+
+		keys = append(keys, s.keyData(key, req.Decrypt != nil && *req.Decrypt))
+	}
+
+	keys, pagination := page(keys, req.Cursor, req.Limit)
+
+	return keys, pagination, nil
+}
@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+
+package fakeunkey
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/unkeyed/sdks/api/go/v2/models/components"
+)
+
+// The methods below change the server state the way a change in the Unkey
+// dashboard would, so tests can check how the provider handles drift.
+
+// Delete deletes the API, key, identity, permission or role with the ID.
+func (s *Server) Delete(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.removeAPI(id) || s.removeIdentity(id) || s.removePermission(id) || s.removeRole(id) {
+		return nil
+	}
+
+	if _, ok := s.keys[id]; ok {
+		delete(s.keys, id)
+		return nil
+	}
+
+	return fmt.Errorf("%s does not exist", id)
+}
+
+// UpdateKey changes the data of a key.
+func (s *Server) UpdateKey(keyID string, update func(*components.KeyResponseData)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, ok := s.keys[keyID]
+	if !ok {
+		return fmt.Errorf("key %s does not exist", keyID)
+	}
+
+	update(&key.data)
+
+	return nil
+}
+
+// SetKeyRoles replaces the roles of a key.
+func (s *Server) SetKeyRoles(keyID string, roles ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, ok := s.keys[keyID]
+	if !ok {
+		return fmt.Errorf("key %s does not exist", keyID)
+	}
+
+	if err := s.setKeyRoles(key, roles); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SetKeyPermissions replaces the permissions of a key.
+func (s *Server) SetKeyPermissions(keyID string, permissions ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, ok := s.keys[keyID]
+	if !ok {
+		return fmt.Errorf("key %s does not exist", keyID)
+	}
+
+	key.permissions = nil
+	s.assignPermissions(key, permissions)
+
+	return nil
+}
+
+// SetRolePermissions replaces the permissions of a role, which Unkey only
+// allows in its dashboard. Every permission must exist.
+func (s *Server) SetRolePermissions(roleID string, permissions ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role := s.findRole(roleID)
+	if role == nil {
+		return fmt.Errorf("role %s does not exist", roleID)
+	}
+
+	var slugs []string
+	for _, slug := range permissions {
+		permission := s.findPermission(slug)
+		if permission == nil {
+			return fmt.Errorf("permission %s does not exist", slug)
+		}
+		slugs = append(slugs, permission.data.Slug)
+	}
+	role.permissions = slugs
+
+	return nil
+}
+
+// KeyRoles returns the names of the roles of a key.
+func (s *Server) KeyRoles(keyID string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if key, ok := s.keys[keyID]; ok {
+		return slices.Clone(key.roles)
+	}
+
+	return nil
+}
+
+// KeyPermissions returns the slugs of the permissions of a key.
+func (s *Server) KeyPermissions(keyID string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if key, ok := s.keys[keyID]; ok {
+		return slices.Clone(key.permissions)
+	}
+
+	return nil
+}
+
+// KeyHash returns the hash Unkey stores for a key, which is all it knows of a
+// migrated key.
+func (s *Server) KeyHash(keyID string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if key, ok := s.keys[keyID]; ok {
+		return key.hash
+	}
+
+	return ""
+}
+
+// Exists reports whether an API, key, identity, permission or role with the
+// ID exists.
+func (s *Server) Exists(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, api := s.apis[id]
+	_, key := s.keys[id]
+	_, identity := s.identities[id]
+	_, permission := s.permissions[id]
+	_, role := s.roles[id]
+
+	return api || key || identity || permission || role
+}
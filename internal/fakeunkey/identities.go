@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+
+package fakeunkey
+
+import (
+	"github.com/unkeyed/sdks/api/go/v2/models/components"
+)
+
+func (s *Server) createIdentity(req components.V2IdentitiesCreateIdentityRequestBody) (any, *apiError) {
+	if req.ExternalID == "" {
+		return nil, badRequest("externalId is required")
+	}
+	if s.findIdentity(req.ExternalID) != nil {
+		return nil, conflict("identity with externalId %s already exists", req.ExternalID)
+	}
+
+	identity := s.identityFor(req.ExternalID)
+	identity.data.Meta = req.Meta
+	identity.data.Ratelimits = s.ratelimits(req.Ratelimits)
+
+	return components.V2IdentitiesCreateIdentityResponseData{IdentityID: identity.data.ID}, nil
+}
+
+func (s *Server) getIdentity(req components.V2IdentitiesGetIdentityRequestBody) (any, *apiError) {
+	identity := s.findIdentity(req.Identity)
+	if identity == nil {
+		return nil, notFound("identity %s does not exist", req.Identity)
+	}
+
+	return identity.data, nil
+}
+
+func (s *Server) updateIdentity(req components.V2IdentitiesUpdateIdentityRequestBody) (any, *apiError) {
+	identity := s.findIdentity(req.Identity)
+	if identity == nil {
+		return nil, notFound("identity %s does not exist", req.Identity)
+	}
+
+	if req.Meta != nil {
+		identity.data.Meta = req.Meta
+	}
+	if req.Ratelimits != nil {
+		identity.data.Ratelimits = s.ratelimits(req.Ratelimits)
+	}
+
+	return identity.data, nil
+}
+
+func (s *Server) deleteIdentity(req components.V2IdentitiesDeleteIdentityRequestBody) (any, *apiError) {
+	identity := s.findIdentity(req.Identity)
+	if identity == nil {
+		return nil, notFound("identity %s does not exist", req.Identity)
+	}
+
+	s.removeIdentity(identity.data.ID)
+
+	return components.EmptyResponse{}, nil
+}
+
+// removeIdentity deletes an identity and unlinks its keys.
+func (s *Server) removeIdentity(id string) bool {
+	if _, ok := s.identities[id]; !ok {
+		return false
+	}
+
+	for _, key := range s.keys {
+		if key.identityID == id {
+			key.identityID = ""
+		}
+	}
+	delete(s.identities, id)
+
+	return true
+}
+
+// findIdentity returns the identity with an ID or external ID, or nil.
+func (s *Server) findIdentity(idOrExternalID string) *identity {
+	if identity, ok := s.identities[idOrExternalID]; ok {
+		return identity
+	}
+
+	for _, identity := range s.identities {
+		if identity.data.ExternalID == idOrExternalID {
+			return identity
+		}
+	}
+
+	return nil
+}
+
+// identityFor returns the identity with an external ID, creating it when it
+// does not exist yet as Unkey does for the external ID of a key.
+func (s *Server) identityFor(externalID string) *identity {
+	if identity := s.findIdentity(externalID); identity != nil {
+		return identity
+	}
+
+	identity := &identity{data: components.Identity{
+		ID:         s.newID("id"),
+		ExternalID: externalID,
+	}}
+	s.identities[identity.data.ID] = identity
+
+	return identity
+}
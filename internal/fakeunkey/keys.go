@@ -0,0 +1,485 @@
+// Copyright (c) HashiCorp, Inc.
+
+package fakeunkey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/unkeyed/sdks/api/go/v2/models/components"
+)
+
+func (s *Server) createKey(req components.V2KeysCreateKeyRequestBody) (any, *apiError) {
+	if _, ok := s.apis[req.APIID]; !ok {
+		return nil, notFound("api %s does not exist", req.APIID)
+	}
+
+	byteLength := int64(16)
+	if req.ByteLength != nil {
+		byteLength = *req.ByteLength
+	}
+
+	secret := randomHex(int(byteLength))
+	if req.Prefix != nil && *req.Prefix != "" {
+		secret = *req.Prefix + "_" + secret
+	}
+
+	key := &key{
+		data: components.KeyResponseData{
+			KeyID:     s.newID("key"),
+			Start:     start(secret),
+			Enabled:   req.Enabled == nil || *req.Enabled,
+			Name:      req.Name,
+			Meta:      req.Meta,
+			CreatedAt: time.Now().UnixMilli(),
+			Expires:   req.Expires,
+			Credits:   req.Credits,
+		},
+		apiID:       req.APIID,
+		secret:      secret,
+		hash:        hash(secret),
+		recoverable: req.Recoverable != nil && *req.Recoverable,
+	}
+
+	if err := s.assignRoles(key, req.Roles); err != nil {
+		return nil, err
+	}
+	s.assignPermissions(key, req.Permissions)
+
+	if req.ExternalID != nil {
+		key.identityID = s.identityFor(*req.ExternalID).data.ID
+	}
+
+	key.data.Ratelimits = s.ratelimits(req.Ratelimits)
+
+	s.keys[key.data.KeyID] = key
+
+	return components.V2KeysCreateKeyResponseData{KeyID: key.data.KeyID, Key: secret}, nil
+}
+
+func (s *Server) getKey(req components.V2KeysGetKeyRequestBody) (any, *apiError) {
+	key, ok := s.keys[req.KeyID]
+	if !ok {
+		return nil, notFound("key %s does not exist", req.KeyID)
+	}
+
+	return s.keyData(key, req.Decrypt != nil && *req.Decrypt), nil
+}
+
+// updateKey changes the fields that are set in the request and leaves the
+// others as they are.
+func (s *Server) updateKey(req components.V2KeysUpdateKeyRequestBody) (any, *apiError) {
+	key, ok := s.keys[req.KeyID]
+	if !ok {
+		return nil, notFound("key %s does not exist", req.KeyID)
+	}
+
+	if req.Roles != nil {
+		if err := s.setKeyRoles(key, req.Roles); err != nil {
+			return nil, err
+		}
+	}
+	if req.Permissions != nil {
+		key.permissions = nil
+		s.assignPermissions(key, req.Permissions)
+	}
+
+	if req.Name != nil {
+		key.data.Name = req.Name
+	}
+	if req.ExternalID != nil {
+		key.identityID = s.identityFor(*req.ExternalID).data.ID
+	}
+	if req.Meta != nil {
+		key.data.Meta = req.Meta
+	}
+	if req.Expires != nil {
+		key.data.Expires = req.Expires
+	}
+	if req.Enabled != nil {
+		key.data.Enabled = *req.Enabled
+	}
+	if req.Ratelimits != nil {
+		key.data.Ratelimits = s.ratelimits(req.Ratelimits)
+	}
+
+	if req.Credits != nil {
+		credits := &components.KeyCreditsData{Remaining: req.Credits.Remaining}
+		if req.Credits.Refill != nil {
+			credits.Refill = &components.KeyCreditsRefill{
+				Interval:  components.KeyCreditsRefillInterval(req.Credits.Refill.Interval),
+				Amount:    req.Credits.Refill.Amount,
+				RefillDay: req.Credits.Refill.RefillDay,
+			}
+		}
+		key.data.Credits = credits
+	}
+
+	updatedAt := time.Now().UnixMilli()
+	key.data.UpdatedAt = &updatedAt
+
+	return components.EmptyResponse{}, nil
+}
+
+// rerollKey creates a key with the configuration of an existing one and
+// revokes the existing key after the expiration, or immediately when it is 0.
+func (s *Server) rerollKey(req components.V2KeysRerollKeyRequestBody) (any, *apiError) {
+	original, ok := s.keys[req.KeyID]
+	if !ok {
+		return nil, notFound("key %s does not exist", req.KeyID)
+	}
+
+	secret := randomHex(16)
+	if i := strings.LastIndex(original.secret, "_"); i >= 0 {
+		secret = original.secret[:i] + "_" + secret
+	}
+
+	key := &key{
+		data:        original.data,
+		apiID:       original.apiID,
+		secret:      secret,
+		hash:        hash(secret),
+		recoverable: original.recoverable,
+		identityID:  original.identityID,
+		roles:       slices.Clone(original.roles),
+		permissions: slices.Clone(original.permissions),
+	}
+	key.data.KeyID = s.newID("key")
+	key.data.Start = start(secret)
+	key.data.CreatedAt = time.Now().UnixMilli()
+	key.data.UpdatedAt = nil
+
+	s.keys[key.data.KeyID] = key
+
+	if req.Expiration == 0 {
+		delete(s.keys, original.data.KeyID)
+	} else {
+		expires := time.Now().UnixMilli() + req.Expiration
+		original.data.Expires = &expires
+	}
+
+	return components.V2KeysRerollKeyResponseData{KeyID: key.data.KeyID, Key: secret}, nil
+}
+
+// migrateKeysRequest is the body of keys.migrateKeys, which the Unkey SDK has
+// no operation for.
+type migrateKeysRequest struct {
+	MigrationID string `json:"migrationId"`
+	APIID       string `json:"apiId"`
+	Keys        []struct {
+		Hash        string                        `json:"hash"`
+		Prefix      *string                       `json:"prefix"`
+		Name        *string                       `json:"name"`
+		ExternalID  *string                       `json:"externalId"`
+		Meta        map[string]any                `json:"meta"`
+		Roles       []string                      `json:"roles"`
+		Permissions []string                      `json:"permissions"`
+		Expires     *int64                        `json:"expires"`
+		Enabled     *bool                         `json:"enabled"`
+		Credits     *components.KeyCreditsData    `json:"credits"`
+		Ratelimits  []components.RatelimitRequest `json:"ratelimits"`
+	} `json:"keys"`
+}
+
+type migratedKey struct {
+	Hash  string `json:"hash"`
+	KeyID string `json:"keyId"`
+}
+
+type failedKey struct {
+	Hash  string `json:"hash"`
+	Error string `json:"error"`
+}
+
+// migrateKeys adds keys by their hash. A key whose hash is already known
+// fails on its own, without failing the others.
+func (s *Server) migrateKeys(req migrateKeysRequest) (any, *apiError) {
+	if _, ok := s.apis[req.APIID]; !ok {
+		return nil, notFound("api %s does not exist", req.APIID)
+	}
+	if req.MigrationID == "" {
+		return nil, badRequest("migrationId is required")
+	}
+
+	migrated := []migratedKey{}
+	failed := []failedKey{}
+
+	for _, data := range req.Keys {
+		if data.Hash == "" || s.findHash(data.Hash) {
+			failed = append(failed, failedKey{Hash: data.Hash, Error: "a key with this hash already exists"})
+			continue
+		}
+
+		// Unkey never sees the key, so only the prefix of it is known
+		var start string
+		if data.Prefix != nil && *data.Prefix != "" {
+			start = *data.Prefix + "_"
+		}
+
+		key := &key{
+			data: components.KeyResponseData{
+				KeyID:     s.newID("key"),
+				Start:     start,
+				Enabled:   data.Enabled == nil || *data.Enabled,
+				Name:      data.Name,
+				Meta:      data.Meta,
+				CreatedAt: time.Now().UnixMilli(),
+				Expires:   data.Expires,
+				Credits:   data.Credits,
+			},
+			apiID: req.APIID,
+			hash:  data.Hash,
+		}
+
+		if err := s.assignRoles(key, data.Roles); err != nil {
+			failed = append(failed, failedKey{Hash: data.Hash, Error: err.detail})
+			continue
+		}
+		s.assignPermissions(key, data.Permissions)
+
+		if data.ExternalID != nil {
+			key.identityID = s.identityFor(*data.ExternalID).data.ID
+		}
+
+		key.data.Ratelimits = s.ratelimits(data.Ratelimits)
+
+		s.keys[key.data.KeyID] = key
+		migrated = append(migrated, migratedKey{Hash: data.Hash, KeyID: key.data.KeyID})
+	}
+
+	return map[string]any{"migrated": migrated, "failed": failed}, nil
+}
+
+// findHash reports whether a key with the hash exists.
+func (s *Server) findHash(hash string) bool {
+	for _, key := range s.keys {
+		if key.hash == hash {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Server) deleteKey(req components.V2KeysDeleteKeyRequestBody) (any, *apiError) {
+	if _, ok := s.keys[req.KeyID]; !ok {
+		return nil, notFound("key %s does not exist", req.KeyID)
+	}
+
+	delete(s.keys, req.KeyID)
+
+	return components.EmptyResponse{}, nil
+}
+
+func (s *Server) addRoles(req components.V2KeysAddRolesRequestBody) (any, *apiError) {
+	key, ok := s.keys[req.KeyID]
+	if !ok {
+		return nil, notFound("key %s does not exist", req.KeyID)
+	}
+
+	if err := s.assignRoles(key, req.Roles); err != nil {
+		return nil, err
+	}
+
+	return s.keyRoles(key), nil
+}
+
+func (s *Server) removeRoles(req components.V2KeysRemoveRolesRequestBody) (any, *apiError) {
+	key, ok := s.keys[req.KeyID]
+	if !ok {
+		return nil, notFound("key %s does not exist", req.KeyID)
+	}
+
+	for _, name := range req.Roles {
+		role := s.findRole(name)
+		if role == nil {
+			return nil, notFound("role %s does not exist", name)
+		}
+		key.roles = remove(key.roles, role.data.Name)
+	}
+
+	return s.keyRoles(key), nil
+}
+
+func (s *Server) setRoles(req components.V2KeysSetRolesRequestBody) (any, *apiError) {
+	key, ok := s.keys[req.KeyID]
+	if !ok {
+		return nil, notFound("key %s does not exist", req.KeyID)
+	}
+
+	if err := s.setKeyRoles(key, req.Roles); err != nil {
+		return nil, err
+	}
+
+	return s.keyRoles(key), nil
+}
+
+func (s *Server) addPermissions(req components.V2KeysAddPermissionsRequestBody) (any, *apiError) {
+	key, ok := s.keys[req.KeyID]
+	if !ok {
+		return nil, notFound("key %s does not exist", req.KeyID)
+	}
+
+	s.assignPermissions(key, req.Permissions)
+
+	return s.keyPermissions(key), nil
+}
+
+func (s *Server) removePermissions(req components.V2KeysRemovePermissionsRequestBody) (any, *apiError) {
+	key, ok := s.keys[req.KeyID]
+	if !ok {
+		return nil, notFound("key %s does not exist", req.KeyID)
+	}
+
+	for _, slug := range req.Permissions {
+		permission := s.findPermission(slug)
+		if permission == nil {
+			return nil, notFound("permission %s does not exist", slug)
+		}
+		key.permissions = remove(key.permissions, permission.data.Slug)
+	}
+
+	return s.keyPermissions(key), nil
+}
+
+func (s *Server) setPermissions(req components.V2KeysSetPermissionsRequestBody) (any, *apiError) {
+	key, ok := s.keys[req.KeyID]
+	if !ok {
+		return nil, notFound("key %s does not exist", req.KeyID)
+	}
+
+	key.permissions = nil
+	s.assignPermissions(key, req.Permissions)
+
+	return s.keyPermissions(key), nil
+}
+
+// keyData returns a key as Unkey reports it, with its plaintext when it is
+// recoverable and decrypt is requested.
+func (s *Server) keyData(key *key, decrypt bool) components.KeyResponseData {
+	data := key.data
+	data.Roles = slices.Clone(key.roles)
+	data.Permissions = slices.Clone(key.permissions)
+
+	if identity, ok := s.identities[key.identityID]; ok {
+		data.Identity = &identity.data
+	}
+
+	if decrypt && key.recoverable {
+		data.Plaintext = &key.secret
+	}
+
+	return data
+}
+
+// assignRoles adds roles, by name or ID, to a key. Every role must exist.
+func (s *Server) assignRoles(key *key, roles []string) *apiError {
+	for _, name := range roles {
+		role := s.findRole(name)
+		if role == nil {
+			return notFound("role %s does not exist", name)
+		}
+		if !slices.Contains(key.roles, role.data.Name) {
+			key.roles = append(key.roles, role.data.Name)
+		}
+	}
+
+	return nil
+}
+
+// setKeyRoles replaces the roles of a key, leaving them unchanged when one of
+// the roles does not exist.
+func (s *Server) setKeyRoles(key *key, roles []string) *apiError {
+	previous := key.roles
+	key.roles = nil
+
+	if err := s.assignRoles(key, roles); err != nil {
+		key.roles = previous
+		return err
+	}
+
+	return nil
+}
+
+// assignPermissions adds permissions, by slug or ID, to a key. Like Unkey, it
+// creates the permissions that do not exist yet.
+func (s *Server) assignPermissions(key *key, permissions []string) {
+	for _, slug := range permissions {
+		found := s.findPermission(slug)
+		if found == nil {
+			found = &permission{data: components.Permission{
+				ID:   s.newID("perm"),
+				Name: slug,
+				Slug: slug,
+			}}
+			s.permissions[found.data.ID] = found
+		}
+		if !slices.Contains(key.permissions, found.data.Slug) {
+			key.permissions = append(key.permissions, found.data.Slug)
+		}
+	}
+}
+
+func (s *Server) keyRoles(key *key) []components.Role {
+	roles := []components.Role{}
+	for _, name := range key.roles {
+		roles = append(roles, s.roleData(s.findRole(name)))
+	}
+
+	return roles
+}
+
+func (s *Server) keyPermissions(key *key) []components.Permission {
+	permissions := []components.Permission{}
+	for _, slug := range key.permissions {
+		permissions = append(permissions, s.findPermission(slug).data)
+	}
+
+	return permissions
+}
+
+// ratelimits returns the rate limits of a request as stored by Unkey.
+func (s *Server) ratelimits(ratelimits []components.RatelimitRequest) []components.RatelimitResponse {
+	var result []components.RatelimitResponse
+	for _, ratelimit := range ratelimits {
+		result = append(result, components.RatelimitResponse{
+			ID:        s.newID("rl"),
+			Name:      ratelimit.Name,
+			Limit:     ratelimit.Limit,
+			Duration:  ratelimit.Duration,
+			AutoApply: ratelimit.AutoApply != nil && *ratelimit.AutoApply,
+		})
+	}
+
+	return result
+}
+
+// start is the part of a key that Unkey reports: the prefix with the first
+// characters of the random part.
+func start(secret string) string {
+	random := strings.LastIndex(secret, "_") + 1
+	return secret[:min(len(secret), random+4)]
+}
+
+// hash is how Unkey stores a key: the base64 encoded SHA-256 of it.
+func hash(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+func randomHex(bytes int) string {
+	b := make([]byte, bytes)
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
+
+// remove returns the values without value.
+func remove(values []string, value string) []string {
+	return slices.DeleteFunc(values, func(v string) bool { return v == value })
+}
@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+
+package fakeunkey
+
+import (
+	"github.com/unkeyed/sdks/api/go/v2/models/components"
+)
+
+func (s *Server) createPermission(req components.V2PermissionsCreatePermissionRequestBody) (any, *apiError) {
+	if req.Name == "" || req.Slug == "" {
+		return nil, badRequest("name and slug are required")
+	}
+	if s.findPermission(req.Slug) != nil {
+		return nil, conflict("permission with slug %s already exists", req.Slug)
+	}
+
+	permission := &permission{data: components.Permission{
+		ID:          s.newID("perm"),
+		Name:        req.Name,
+		Slug:        req.Slug,
+		Description: req.Description,
+	}}
+	s.permissions[permission.data.ID] = permission
+
+	return components.V2PermissionsCreatePermissionResponseData{PermissionID: permission.data.ID}, nil
+}
+
+// getPermission only accepts permission IDs, unlike deletePermission which
+// also accepts slugs.
+func (s *Server) getPermission(req components.V2PermissionsGetPermissionRequestBody) (any, *apiError) {
+	permission, ok := s.permissions[req.Permission]
+	if !ok {
+		return nil, notFound("permission %s does not exist", req.Permission)
+	}
+
+	return permission.data, nil
+}
+
+func (s *Server) deletePermission(req components.V2PermissionsDeletePermissionRequestBody) (any, *apiError) {
+	permission := s.findPermission(req.Permission)
+	if permission == nil {
+		return nil, notFound("permission %s does not exist", req.Permission)
+	}
+
+	s.removePermission(permission.data.ID)
+
+	return components.EmptyResponse{}, nil
+}
+
+func (s *Server) listPermissions(req components.V2PermissionsListPermissionsRequestBody) (any, components.Pagination, *apiError) {
+	permissions := []components.Permission{}
+	for _, id := range sortedIDs(s.permissions) {
+		permissions = append(permissions, s.permissions[id].data)
+	}
+
+	permissions, pagination := page(permissions, req.Cursor, req.Limit)
+
+	return permissions, pagination, nil
+}
+
+// removePermission deletes a permission and revokes it from keys and roles.
+func (s *Server) removePermission(id string) bool {
+	permission, ok := s.permissions[id]
+	if !ok {
+		return false
+	}
+
+	for _, key := range s.keys {
+		key.permissions = remove(key.permissions, permission.data.Slug)
+	}
+	for _, role := range s.roles {
+		role.permissions = remove(role.permissions, permission.data.Slug)
+	}
+	delete(s.permissions, id)
+
+	return true
+}
+
+// findPermission returns the permission with an ID or slug, or nil.
+func (s *Server) findPermission(idOrSlug string) *permission {
+	if permission, ok := s.permissions[idOrSlug]; ok {
+		return permission
+	}
+
+	for _, permission := range s.permissions {
+		if permission.data.Slug == idOrSlug {
+			return permission
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) createRole(req components.V2PermissionsCreateRoleRequestBody) (any, *apiError) {
+	if req.Name == "" {
+		return nil, badRequest("name is required")
+	}
+	if s.findRole(req.Name) != nil {
+		return nil, conflict("role with name %s already exists", req.Name)
+	}
+
+	role := &role{data: components.Role{
+		ID:          s.newID("role"),
+		Name:        req.Name,
+		Description: req.Description,
+	}}
+	s.roles[role.data.ID] = role
+
+	return components.V2PermissionsCreateRoleResponseData{RoleID: role.data.ID}, nil
+}
+
+func (s *Server) getRole(req components.V2PermissionsGetRoleRequestBody) (any, *apiError) {
+	role := s.findRole(req.Role)
+	if role == nil {
+		return nil, notFound("role %s does not exist", req.Role)
+	}
+
+	return s.roleData(role), nil
+}
+
+func (s *Server) deleteRole(req components.V2PermissionsDeleteRoleRequestBody) (any, *apiError) {
+	role := s.findRole(req.Role)
+	if role == nil {
+		return nil, notFound("role %s does not exist", req.Role)
+	}
+
+	s.removeRole(role.data.ID)
+
+	return components.EmptyResponse{}, nil
+}
+
+func (s *Server) listRoles(req components.V2PermissionsListRolesRequestBody) (any, components.Pagination, *apiError) {
+	roles := []components.Role{}
+	for _, id := range sortedIDs(s.roles) {
+		roles = append(roles, s.roleData(s.roles[id]))
+	}
+
+	roles, pagination := page(roles, req.Cursor, req.Limit)
+
+	return roles, pagination, nil
+}
+
+// removeRole deletes a role and revokes it from keys.
+func (s *Server) removeRole(id string) bool {
+	role, ok := s.roles[id]
+	if !ok {
+		return false
+	}
+
+	for _, key := range s.keys {
+		key.roles = remove(key.roles, role.data.Name)
+	}
+	delete(s.roles, id)
+
+	return true
+}
+
+// findRole returns the role with an ID or name, or nil.
+func (s *Server) findRole(idOrName string) *role {
+	if role, ok := s.roles[idOrName]; ok {
+		return role
+	}
+
+	for _, role := range s.roles {
+		if role.data.Name == idOrName {
+			return role
+		}
+	}
+
+	return nil
+}
+
+// roleData returns a role with its permissions as Unkey reports it.
+func (s *Server) roleData(role *role) components.Role {
+	data := role.data
+	for _, slug := range role.permissions {
+		data.Permissions = append(data.Permissions, s.findPermission(slug).data)
+	}
+
+	return data
+}
@@ -0,0 +1,293 @@
+// Copyright (c) HashiCorp, Inc.
+
+// Package fakeunkey is an in-memory Unkey v2 API for testing the provider
+// without network access. It implements the apis, keys, identities,
+// permissions and roles endpoints the provider calls, with the request and
+// response bodies of the Unkey SDK.
+package fakeunkey
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/unkeyed/sdks/api/go/v2/models/components"
+)
+
+// RootKey is the only root key the server accepts.
+const RootKey = "unkey_fake_root_key"
+
+// Server is a running fake Unkey API. Its state can be changed directly to
+// simulate changes made outside of Terraform.
+type Server struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	lastID      int
+	apis        map[string]*api
+	keys        map[string]*key
+	identities  map[string]*identity
+	permissions map[string]*permission
+	roles       map[string]*role
+}
+
+type api struct {
+	id   string
+	name string
+}
+
+type key struct {
+	data        components.KeyResponseData
+	apiID       string
+	secret      string
+	hash        string
+	recoverable bool
+	identityID  string
+	roles       []string
+	permissions []string
+}
+
+type identity struct {
+	data components.Identity
+}
+
+type permission struct {
+	data components.Permission
+}
+
+type role struct {
+	data        components.Role
+	permissions []string
+}
+
+// NewServer starts a fake Unkey API. It is closed when the test ends.
+func NewServer(t interface{ Cleanup(func()) }) *Server {
+	s := &Server{
+		apis:        map[string]*api{},
+		keys:        map[string]*key{},
+		identities:  map[string]*identity{},
+		permissions: map[string]*permission{},
+		roles:       map[string]*role{},
+	}
+
+	mux := http.NewServeMux()
+	for operation, handler := range s.handlers() {
+		mux.HandleFunc("POST /v2/"+operation, s.authenticate(handler))
+	}
+
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// apiError is an Unkey error response.
+type apiError struct {
+	status int
+	detail string
+}
+
+func (e *apiError) Error() string {
+	return e.detail
+}
+
+func notFound(format string, args ...any) *apiError {
+	return &apiError{status: http.StatusNotFound, detail: fmt.Sprintf(format, args...)}
+}
+
+func conflict(format string, args ...any) *apiError {
+	return &apiError{status: http.StatusConflict, detail: fmt.Sprintf(format, args...)}
+}
+
+func badRequest(format string, args ...any) *apiError {
+	return &apiError{status: http.StatusBadRequest, detail: fmt.Sprintf(format, args...)}
+}
+
+// operation decodes the request body into Req, calls fn under the server
+// lock and writes its result as the data of the response.
+func operation[Req any](s *Server, fn func(Req) (any, *apiError)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req Req
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			s.writeError(w, badRequest("invalid request body: %s", err))
+			return
+		}
+
+		// The data shares maps and slices with the server state, so it is
+		// encoded before the lock is released
+		s.mu.Lock()
+		data, apiErr := fn(req)
+		encoded, err := json.Marshal(data)
+		s.mu.Unlock()
+
+		if apiErr != nil {
+			s.writeError(w, apiErr)
+			return
+		}
+		if err != nil {
+			s.writeError(w, &apiError{status: http.StatusInternalServerError, detail: err.Error()})
+			return
+		}
+
+		s.write(w, http.StatusOK, map[string]any{
+			"meta": s.meta(),
+			"data": json.RawMessage(encoded),
+		})
+	}
+}
+
+func (s *Server) authenticate(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+RootKey {
+			s.writeError(w, &apiError{status: http.StatusUnauthorized, detail: "invalid root key"})
+			return
+		}
+
+		next(w, r)
+	}
+}
+
+func (s *Server) meta() components.Meta {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return components.Meta{RequestID: s.newID("req")}
+}
+
+func (s *Server) write(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func (s *Server) writeError(w http.ResponseWriter, err *apiError) {
+	body := map[string]any{
+		"title":  http.StatusText(err.status),
+		"detail": err.detail,
+		"status": err.status,
+		"type":   "https://unkey.com/docs/errors/" + strings.ReplaceAll(strings.ToLower(http.StatusText(err.status)), " ", "_"),
+	}
+	if err.status == http.StatusBadRequest {
+		body["errors"] = []any{}
+	}
+
+	s.write(w, err.status, map[string]any{
+		"meta":  s.meta(),
+		"error": body,
+	})
+}
+
+// newID returns a new unique ID with the prefix, such as key_00000003. IDs
+// sort in the order they were created.
+func (s *Server) newID(prefix string) string {
+	s.lastID++
+	return fmt.Sprintf("%s_%08d", prefix, s.lastID)
+}
+
+// sortedIDs returns the IDs of the items in the order they were created.
+func sortedIDs[T any](items map[string]T) []string {
+	return slices.Sorted(maps.Keys(items))
+}
+
+// page returns the items of a list from the cursor on, at most limit of them,
+// with the pagination of the remaining items.
+func page[T any](items []T, cursor *string, limit *int64) ([]T, components.Pagination) {
+	start := 0
+	if cursor != nil {
+		_, _ = fmt.Sscanf(*cursor, "%d", &start)
+	}
+	start = min(start, len(items))
+
+	size := 100
+	if limit != nil && *limit > 0 {
+		size = int(*limit)
+	}
+
+	end := min(start+size, len(items))
+	pagination := components.Pagination{HasMore: end < len(items)}
+	if pagination.HasMore {
+		next := fmt.Sprint(end)
+		pagination.Cursor = &next
+	}
+
+	return items[start:end], pagination
+}
+
+// paged is a response with pagination next to its data.
+type paged struct {
+	Meta       components.Meta       `json:"meta"`
+	Data       json.RawMessage       `json:"data"`
+	Pagination components.Pagination `json:"pagination"`
+}
+
+// list writes a paginated list response.
+func list[Req any](s *Server, fn func(Req) (any, components.Pagination, *apiError)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req Req
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			s.writeError(w, badRequest("invalid request body: %s", err))
+			return
+		}
+
+		s.mu.Lock()
+		data, pagination, apiErr := fn(req)
+		encoded, err := json.Marshal(data)
+		s.mu.Unlock()
+
+		if apiErr != nil {
+			s.writeError(w, apiErr)
+			return
+		}
+		if err != nil {
+			s.writeError(w, &apiError{status: http.StatusInternalServerError, detail: err.Error()})
+			return
+		}
+
+		s.write(w, http.StatusOK, paged{
+			Meta:       s.meta(),
+			Data:       json.RawMessage(encoded),
+			Pagination: pagination,
+		})
+	}
+}
+
+func (s *Server) handlers() map[string]http.HandlerFunc {
+	return map[string]http.HandlerFunc{
+		"apis.createApi": operation(s, s.createAPI),
+		"apis.getApi":    operation(s, s.getAPI),
+		"apis.deleteApi": operation(s, s.deleteAPI),
+		"apis.listKeys":  list(s, s.listKeys),
+
+		"keys.createKey":         operation(s, s.createKey),
+		"keys.getKey":            operation(s, s.getKey),
+		"keys.updateKey":         operation(s, s.updateKey),
+		"keys.deleteKey":         operation(s, s.deleteKey),
+		"keys.rerollKey":         operation(s, s.rerollKey),
+		"keys.migrateKeys":       operation(s, s.migrateKeys),
+		"keys.addRoles":          operation(s, s.addRoles),
+		"keys.removeRoles":       operation(s, s.removeRoles),
+		"keys.setRoles":          operation(s, s.setRoles),
+		"keys.addPermissions":    operation(s, s.addPermissions),
+		"keys.removePermissions": operation(s, s.removePermissions),
+		"keys.setPermissions":    operation(s, s.setPermissions),
+
+		"identities.createIdentity": operation(s, s.createIdentity),
+		"identities.getIdentity":    operation(s, s.getIdentity),
+		"identities.updateIdentity": operation(s, s.updateIdentity),
+		"identities.deleteIdentity": operation(s, s.deleteIdentity),
+
+		"permissions.createPermission": operation(s, s.createPermission),
+		"permissions.getPermission":    operation(s, s.getPermission),
+		"permissions.deletePermission": operation(s, s.deletePermission),
+		"permissions.listPermissions":  list(s, s.listPermissions),
+		"permissions.createRole":       operation(s, s.createRole),
+		"permissions.getRole":          operation(s, s.getRole),
+		"permissions.deleteRole":       operation(s, s.deleteRole),
+		"permissions.listRoles":        list(s, s.listRoles),
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/models"
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unkeyed/sdks/api/go/v2/models/components"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &apiDataSource{}
+	_ datasource.DataSourceWithConfigure = &apiDataSource{}
+)
+
+// NewApiDataSource is a helper function to simplify the provider implementation.
+func NewApiDataSource() datasource.DataSource {
+	return &apiDataSource{}
+}
+
+// apiDataSource is the data source implementation.
+type apiDataSource struct {
+	client *unkeyClient
+}
+
+// Metadata returns the data source type name.
+func (d *apiDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api"
+}
+
+// Schema defines the schema for the data source.
+func (d *apiDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schemas.ApiDataSourceSchema()
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *apiDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get lookup values from configuration
+	var state models.ApiDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.client.requestTimeout)
+	defer cancel()
+
+	api, err := d.client.Apis.GetAPI(ctx, components.V2ApisGetAPIRequestBody{
+		APIID: state.ApiId.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Unkey API",
+			"Could not read Unkey API ID "+state.ApiId.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	data := api.V2ApisGetAPIResponseBody.GetData()
+
+	// Map response body to model
+	state.ApiId = types.StringValue(data.ID)
+	state.Name = types.StringValue(data.Name)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *apiDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unkeyClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.unkeyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/fakeunkey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccApiDataSource(t *testing.T) {
+	server := fakeunkey.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApiDataSourceConfig(server),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.unkey_api.test", "id", "unkey_api.test", "id"),
+					resource.TestCheckResourceAttr("data.unkey_api.test", "name", "payments"),
+				),
+			},
+			// An API that does not exist fails the read
+			{
+				Config:      testAccApiDataSourceMissingConfig(server),
+				ExpectError: regexp.MustCompile("Unable to Read Unkey API"),
+			},
+		},
+	})
+}
+
+func testAccApiDataSourceConfig(server *fakeunkey.Server) string {
+	return testAccProviderConfig(server) + `
+resource "unkey_api" "test" {
+  name = "payments"
+}
+
+data "unkey_api" "test" {
+  id = unkey_api.test.id
+}
+`
+}
+
+func testAccApiDataSourceMissingConfig(server *fakeunkey.Server) string {
+	return testAccProviderConfig(server) + `
+data "unkey_api" "test" {
+  id = "api_missing"
+}
+`
+}
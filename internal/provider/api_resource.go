@@ -8,16 +8,19 @@ import (
 
 	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/models"
 	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	unkey "github.com/unkeyed/sdks/api/go/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/unkeyed/sdks/api/go/v2/models/components"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &apiResource{}
-	_ resource.ResourceWithConfigure = &apiResource{}
+	_ resource.Resource                = &apiResource{}
+	_ resource.ResourceWithConfigure   = &apiResource{}
+	_ resource.ResourceWithImportState = &apiResource{}
+	_ resource.ResourceWithModifyPlan  = &apiResource{}
 )
 
 // NewApiResource is a helper function to simplify the provider implementation.
@@ -27,7 +30,7 @@ func NewApiResource() resource.Resource {
 
 // apiResource is the resource implementation.
 type apiResource struct {
-	client *unkey.Unkey
+	client *unkeyClient
 }
 
 // Metadata returns the resource type name.
@@ -36,8 +39,8 @@ func (r *apiResource) Metadata(_ context.Context, req resource.MetadataRequest,
 }
 
 // Schema defines the schema for the resource.
-func (r *apiResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schemas.ApiSchema()
+func (r *apiResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schemas.ApiSchema(ctx)
 }
 
 // Create a new resource.
@@ -50,6 +53,15 @@ func (r *apiResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.client.requestTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Create new API
 	api, err := r.client.Apis.CreateAPI(ctx, components.V2ApisCreateAPIRequestBody{
 		Name: plan.Name.ValueString(),
@@ -83,11 +95,29 @@ func (r *apiResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, r.client.requestTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed API from Unkey
 	api, err := r.client.Apis.GetAPI(ctx, components.V2ApisGetAPIRequestBody{
 		APIID: state.ApiId.ValueString(),
 	})
 	if err != nil {
+		// The API was deleted outside of Terraform, plan to recreate it
+		if isNotFound(err) {
+			tflog.Warn(ctx, "Unkey API not found, removing from state", map[string]any{
+				"id": state.ApiId.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error Reading Unkey API",
 			"Could not read Unkey API ID "+state.ApiId.ValueString()+": "+err.Error(),
@@ -106,7 +136,23 @@ func (r *apiResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	}
 }
 
+// Update only persists the plan: Unkey cannot change an API in place, so
+// every configurable attribute forces a replacement instead.
 func (r *apiResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan models.ApiResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to plan data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 }
 
 func (r *apiResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -118,11 +164,25 @@ func (r *apiResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.client.requestTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete existing API
 	_, err := r.client.Apis.DeleteAPI(ctx, components.V2ApisDeleteAPIRequestBody{
 		APIID: state.ApiId.ValueString(),
 	})
 	if err != nil {
+		// Nothing left to delete
+		if isNotFound(err) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error Deleting Unkey API",
 			"Could not delete API, unexpected error: "+err.Error(),
@@ -131,6 +191,66 @@ func (r *apiResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 	}
 }
 
+// ImportState imports an API by its ID.
+func (r *apiResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// ModifyPlan warns when a change forces the API to be replaced, because
+// deleting an API also deletes every key that belongs to it.
+func (r *apiResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing is replaced on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan models.ApiResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Name.Equal(state.Name) {
+		return
+	}
+
+	apiId := state.ApiId.ValueString()
+	keys := "all of its keys"
+
+	// Count the keys that would be lost, if the provider is configured yet
+	if r.client != nil {
+		ctx, cancel := context.WithTimeout(ctx, r.client.requestTimeout)
+		defer cancel()
+
+		limit := int64(100)
+		list, err := r.client.Apis.ListKeys(ctx, components.V2ApisListKeysRequestBody{
+			APIID: apiId,
+			Limit: &limit,
+		})
+		if err == nil {
+			body := list.V2ApisListKeysResponseBody
+			count := len(body.GetData())
+			switch {
+			case body.GetPagination().GetHasMore():
+				keys = fmt.Sprintf("at least %d keys", count)
+			case count == 1:
+				keys = "1 key"
+			default:
+				keys = fmt.Sprintf("%d keys", count)
+			}
+		}
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("name"),
+		"Unkey API Will Be Replaced",
+		fmt.Sprintf("Unkey cannot rename an API, so changing the name of API %s from %s to %s deletes it and creates a new one. ", apiId, state.Name, plan.Name)+
+			fmt.Sprintf("Deleting the API permanently deletes %s that belong to it, and every client using those keys will be rejected. ", keys)+
+			"Keys managed in this configuration will be recreated under the new API with new secrets.",
+	)
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *apiResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Add a nil check when handling ProviderData because Terraform
@@ -139,12 +259,12 @@ func (r *apiResource) Configure(_ context.Context, req resource.ConfigureRequest
 		return
 	}
 
-	client, ok := req.ProviderData.(*unkey.Unkey)
+	client, ok := req.ProviderData.(*unkeyClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *unkey.Unkey, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.unkeyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/fakeunkey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccApiResource(t *testing.T) {
+	server := fakeunkey.NewServer(t)
+
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(server, "unkey_api"),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccApiResourceConfig(server, "payments"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unkey_api.test", "name", "payments"),
+					resource.TestCheckResourceAttrSet("unkey_api.test", "id"),
+					testAccCheckID("unkey_api.test", &id),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "unkey_api.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Drift testing: the API is recreated after being deleted outside of Terraform
+			{
+				PreConfig: testAccDelete(t, server, &id),
+				Config:    testAccApiResourceConfig(server, "payments"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("unkey_api.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+			// Update testing: a renamed API is replaced
+			{
+				Config: testAccApiResourceConfig(server, "billing"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("unkey_api.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("unkey_api.test", "name", "billing"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccApiResourceConfig(server *fakeunkey.Server, name string) string {
+	return testAccProviderConfig(server) + fmt.Sprintf(`
+resource "unkey_api" "test" {
+  name = %q
+}
+`, name)
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	unkey "github.com/unkeyed/sdks/api/go/v2"
+	"github.com/unkeyed/sdks/api/go/v2/models/components"
+)
+
+// unkeyClient is the Unkey SDK client handed to resources and data sources,
+// together with the provider settings that apply to every call they make.
+type unkeyClient struct {
+	*unkey.Unkey
+
+	// requestTimeout bounds every operation that does not configure its
+	// own timeout.
+	requestTimeout time.Duration
+
+	// httpClient, serverURL and rootKey send the requests the SDK has no
+	// operation for, through the same transports as the SDK.
+	httpClient *http.Client
+	serverURL  string
+	rootKey    string
+}
+
+// validateRootKey checks that Unkey accepts the root key, so a wrong or revoked
+// root key fails before anything is planned. Unkey has no endpoint that
+// describes a root key, so the cheapest authenticated request is sent
+// instead: a root key that lacks the permission to list permissions is still
+// a valid root key.
+func validateRootKey(ctx context.Context, client *unkeyClient, source string, diags *diag.Diagnostics) {
+	ctx, cancel := context.WithTimeout(ctx, client.requestTimeout)
+	defer cancel()
+
+	limit := int64(1)
+	_, err := client.Permissions.ListPermissions(ctx, components.V2PermissionsListPermissionsRequestBody{
+		Limit: &limit,
+	})
+
+	switch {
+	case err == nil, isForbidden(err):
+		tflog.Info(ctx, "Validated Unkey API root key", map[string]any{"source": source})
+	case isUnauthorized(err):
+		diags.AddError(
+			"Invalid Unkey API Root Key",
+			"Unkey rejected the root key read from "+source+": "+err.Error(),
+		)
+	default:
+		diags.AddWarning(
+			"Unable to Validate Unkey API Root Key",
+			"The provider could not check the root key read from "+source+" with Unkey: "+err.Error(),
+		)
+	}
+}
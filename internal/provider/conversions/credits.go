@@ -45,15 +45,23 @@ func CreditsToAPI(ctx context.Context, creditsObj types.Object) (*components.Key
 
 func CreditsToUpdateAPI(ctx context.Context, creditsObj types.Object) (*components.UpdateKeyCreditsData, diag.Diagnostics) {
 	keyCredits, diags := CreditsToAPI(ctx, creditsObj)
+	if keyCredits == nil {
+		return nil, diags
+	}
 
-	return &components.UpdateKeyCreditsData{
+	updateCredits := &components.UpdateKeyCreditsData{
 		Remaining: keyCredits.Remaining,
-		Refill: &components.UpdateKeyCreditsRefill{
+	}
+
+	if keyCredits.Refill != nil {
+		updateCredits.Refill = &components.UpdateKeyCreditsRefill{
 			Interval:  components.UpdateKeyCreditsRefillInterval(keyCredits.Refill.Interval),
 			Amount:    keyCredits.Refill.Amount,
 			RefillDay: keyCredits.Refill.RefillDay,
-		},
-	}, diags
+		}
+	}
+
+	return updateCredits, diags
 }
 
 // API -> Plan
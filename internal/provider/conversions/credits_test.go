@@ -0,0 +1,193 @@
+package conversions
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"testing/quick"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unkeyed/sdks/api/go/v2/models/components"
+
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/models"
+)
+
+func refillObject(interval string, amount int64, refillDay types.Int64) types.Object {
+	return types.ObjectValueMust(models.CreditsRefillAttrTypes, map[string]attr.Value{
+		"interval":   types.StringValue(interval),
+		"amount":     types.Int64Value(amount),
+		"refill_day": refillDay,
+	})
+}
+
+func creditsObject(remaining types.Int64, refill types.Object) types.Object {
+	return types.ObjectValueMust(models.CreditsAttrTypes, map[string]attr.Value{
+		"remaining": remaining,
+		"refill":    refill,
+	})
+}
+
+func TestCreditsRoundTrip(t *testing.T) {
+	noRefill := types.ObjectNull(models.CreditsRefillAttrTypes)
+
+	tests := map[string]types.Object{
+		"null":                      types.ObjectNull(models.CreditsAttrTypes),
+		"remaining":                 creditsObject(types.Int64Value(100), noRefill),
+		"zero remaining":            creditsObject(types.Int64Value(0), noRefill),
+		"unlimited":                 creditsObject(types.Int64Null(), noRefill),
+		"daily refill":              creditsObject(types.Int64Value(100), refillObject("daily", 100, types.Int64Null())),
+		"monthly refill":            creditsObject(types.Int64Value(5), refillObject("monthly", 1000, types.Int64Value(15))),
+		"unlimited with refill":     creditsObject(types.Int64Null(), refillObject("daily", 10, types.Int64Null())),
+		"monthly refill on the 1st": creditsObject(types.Int64Value(0), refillObject("monthly", 1, types.Int64Value(1))),
+	}
+
+	for name, credits := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			apiCredits, diags := CreditsToAPI(ctx, credits)
+			if diags.HasError() {
+				t.Fatalf("CreditsToAPI: %v", diags)
+			}
+
+			got, diags := CreditsFromAPI(ctx, apiCredits)
+			if diags.HasError() {
+				t.Fatalf("CreditsFromAPI: %v", diags)
+			}
+
+			if !got.Equal(credits) {
+				t.Errorf("expected %s, got %s", credits, got)
+			}
+		})
+	}
+}
+
+func TestCreditsToAPIUnknown(t *testing.T) {
+	ctx := context.Background()
+
+	credits, diags := CreditsToAPI(ctx, types.ObjectUnknown(models.CreditsAttrTypes))
+	if diags.HasError() {
+		t.Fatalf("CreditsToAPI: %v", diags)
+	}
+	if credits != nil {
+		t.Errorf("expected unknown credits not to be sent, got %+v", credits)
+	}
+
+	credits, diags = CreditsToAPI(ctx, creditsObject(types.Int64Value(10), types.ObjectUnknown(models.CreditsRefillAttrTypes)))
+	if diags.HasError() {
+		t.Fatalf("CreditsToAPI: %v", diags)
+	}
+	if credits == nil || credits.Refill != nil {
+		t.Errorf("expected an unknown refill not to be sent, got %+v", credits)
+	}
+}
+
+func TestCreditsToUpdateAPI(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		credits types.Object
+		want    *components.UpdateKeyCreditsData
+	}{
+		"null": {
+			credits: types.ObjectNull(models.CreditsAttrTypes),
+			want:    nil,
+		},
+		"unknown": {
+			credits: types.ObjectUnknown(models.CreditsAttrTypes),
+			want:    nil,
+		},
+		"no refill": {
+			credits: creditsObject(types.Int64Value(100), types.ObjectNull(models.CreditsRefillAttrTypes)),
+			want: &components.UpdateKeyCreditsData{
+				Remaining: int64Pointer(100),
+			},
+		},
+		"refill": {
+			credits: creditsObject(types.Int64Value(100), refillObject("monthly", 50, types.Int64Value(3))),
+			want: &components.UpdateKeyCreditsData{
+				Remaining: int64Pointer(100),
+				Refill: &components.UpdateKeyCreditsRefill{
+					Interval:  components.UpdateKeyCreditsRefillIntervalMonthly,
+					Amount:    50,
+					RefillDay: int64Pointer(3),
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, diags := CreditsToUpdateAPI(ctx, test.credits)
+			if diags.HasError() {
+				t.Fatalf("CreditsToUpdateAPI: %v", diags)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("expected %s, got %s", toJSON(test.want), toJSON(got))
+			}
+		})
+	}
+}
+
+// TestCreditsRoundTripProperty checks the round trip for arbitrary credits,
+// with every optional attribute either set or null.
+func TestCreditsRoundTripProperty(t *testing.T) {
+	ctx := context.Background()
+
+	roundTrip := func(remaining int64, hasRemaining, hasRefill, monthly bool, amount int64, refillDay uint8, hasRefillDay bool) bool {
+		remainingValue := types.Int64Null()
+		if hasRemaining {
+			remainingValue = types.Int64Value(remaining)
+		}
+
+		refill := types.ObjectNull(models.CreditsRefillAttrTypes)
+		if hasRefill {
+			interval := "daily"
+			if monthly {
+				interval = "monthly"
+			}
+
+			refillDayValue := types.Int64Null()
+			if hasRefillDay {
+				refillDayValue = types.Int64Value(int64(refillDay))
+			}
+
+			refill = refillObject(interval, amount, refillDayValue)
+		}
+
+		credits := creditsObject(remainingValue, refill)
+
+		apiCredits, diags := CreditsToAPI(ctx, credits)
+		if diags.HasError() {
+			return false
+		}
+
+		got, diags := CreditsFromAPI(ctx, apiCredits)
+		if diags.HasError() {
+			return false
+		}
+
+		return got.Equal(credits)
+	}
+
+	if err := quick.Check(roundTrip, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func int64Pointer(value int64) *int64 {
+	return &value
+}
+
+// toJSON formats API values with the values behind their pointers.
+func toJSON(value any) string {
+	content, err := json.Marshal(value)
+	if err != nil {
+		return err.Error()
+	}
+
+	return string(content)
+}
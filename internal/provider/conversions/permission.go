@@ -0,0 +1,19 @@
+package conversions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unkeyed/sdks/api/go/v2/models/components"
+)
+
+// API -> Plan
+func PermissionSlugsFromAPI(ctx context.Context, permissions []components.Permission) (types.List, diag.Diagnostics) {
+	slugs := make([]string, len(permissions))
+	for i, permission := range permissions {
+		slugs[i] = permission.Slug
+	}
+
+	return SliceToStringList(ctx, slugs)
+}
@@ -3,6 +3,9 @@ package conversions
 import (
 	"context"
 	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -36,12 +39,21 @@ func StringToMap(ctx context.Context, str types.String) (map[string]any, diag.Di
 		return nil, diags
 	}
 
+	// Numbers are kept as written, as float64 would round integers above 2^53
+	decoder := json.NewDecoder(strings.NewReader(str.ValueString()))
+	decoder.UseNumber()
+
 	var result map[string]any
-	if err := json.Unmarshal([]byte(str.ValueString()), &result); err != nil {
+	if err := decoder.Decode(&result); err != nil {
 		diags.AddError("Error unmarshaling string to map", err.Error())
 		return nil, diags
 	}
 
+	if _, err := decoder.Token(); err != io.EOF {
+		diags.AddError("Error unmarshaling string to map", "unexpected data after the JSON object")
+		return nil, diags
+	}
+
 	return result, diags
 }
 
@@ -59,3 +71,51 @@ func MapToString(ctx context.Context, m map[string]any) (types.String, diag.Diag
 
 	return types.StringValue(string(jsonBytes)), diags
 }
+
+// MetaFromAPI reads the meta of the data in an Unkey API response. The SDK
+// decodes meta into float64, which rounds integers above 2^53, so it is
+// decoded again from the raw response with numbers kept as written.
+func MetaFromAPI(ctx context.Context, response *http.Response) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if response == nil || response.Body == nil {
+		diags.AddError("Error reading meta from response", "the response body is not available")
+		return types.StringNull(), diags
+	}
+
+	decoder := json.NewDecoder(response.Body)
+	decoder.UseNumber()
+
+	var body struct {
+		Data struct {
+			Meta map[string]any `json:"meta"`
+		} `json:"data"`
+	}
+	if err := decoder.Decode(&body); err != nil {
+		diags.AddError("Error reading meta from response", err.Error())
+		return types.StringNull(), diags
+	}
+
+	return MapToString(ctx, body.Data.Meta)
+}
+
+func StringSetToSlice(ctx context.Context, set types.Set) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if set.IsNull() || set.IsUnknown() {
+		return nil, diags
+	}
+
+	result := []string{}
+	diags.Append(set.ElementsAs(ctx, &result, false)...)
+	return result, diags
+}
+
+func SliceToStringSet(ctx context.Context, slice []string) (types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if slice == nil {
+		slice = []string{}
+	}
+
+	set, d := types.SetValueFrom(ctx, types.StringType, slice)
+	diags.Append(d...)
+	return set, diags
+}
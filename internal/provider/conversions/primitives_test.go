@@ -0,0 +1,422 @@
+package conversions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"testing/quick"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func stringList(values ...string) types.List {
+	elements := make([]attr.Value, len(values))
+	for i, value := range values {
+		elements[i] = types.StringValue(value)
+	}
+
+	return types.ListValueMust(types.StringType, elements)
+}
+
+func stringSet(values ...string) types.Set {
+	elements := make([]attr.Value, len(values))
+	for i, value := range values {
+		elements[i] = types.StringValue(value)
+	}
+
+	return types.SetValueMust(types.StringType, elements)
+}
+
+func TestStringListRoundTrip(t *testing.T) {
+	tests := map[string]types.List{
+		"null":       types.ListNull(types.StringType),
+		"one":        stringList("admin"),
+		"several":    stringList("documents.read", "documents.write", "documents.*"),
+		"duplicates": stringList("admin", "admin"),
+	}
+
+	for name, list := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			slice, diags := StringListToSlice(ctx, list)
+			if diags.HasError() {
+				t.Fatalf("StringListToSlice: %v", diags)
+			}
+
+			got, diags := SliceToStringList(ctx, slice)
+			if diags.HasError() {
+				t.Fatalf("SliceToStringList: %v", diags)
+			}
+
+			if !got.Equal(list) {
+				t.Errorf("expected %s, got %s", list, got)
+			}
+		})
+	}
+}
+
+func TestStringListToSliceUnknown(t *testing.T) {
+	slice, diags := StringListToSlice(context.Background(), types.ListUnknown(types.StringType))
+	if diags.HasError() {
+		t.Fatalf("StringListToSlice: %v", diags)
+	}
+
+	if slice != nil {
+		t.Errorf("expected an unknown list not to be sent, got %v", slice)
+	}
+}
+
+// Unkey leaves out empty lists of roles and permissions, so they are read back
+// as null.
+func TestSliceToStringListEmpty(t *testing.T) {
+	got, diags := SliceToStringList(context.Background(), []string{})
+	if diags.HasError() {
+		t.Fatalf("SliceToStringList: %v", diags)
+	}
+
+	if !got.IsNull() {
+		t.Errorf("expected null, got %s", got)
+	}
+}
+
+func TestStringListRoundTripProperty(t *testing.T) {
+	ctx := context.Background()
+
+	roundTrip := func(first string, rest []string) bool {
+		list := stringList(append([]string{first}, rest...)...)
+
+		slice, diags := StringListToSlice(ctx, list)
+		if diags.HasError() {
+			return false
+		}
+
+		got, diags := SliceToStringList(ctx, slice)
+		if diags.HasError() {
+			return false
+		}
+
+		return got.Equal(list)
+	}
+
+	if err := quick.Check(roundTrip, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestStringSetRoundTrip(t *testing.T) {
+	tests := map[string]types.Set{
+		"empty":   stringSet(),
+		"one":     stringSet("admin"),
+		"several": stringSet("documents.read", "documents.write"),
+	}
+
+	for name, set := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			slice, diags := StringSetToSlice(ctx, set)
+			if diags.HasError() {
+				t.Fatalf("StringSetToSlice: %v", diags)
+			}
+			if slice == nil {
+				t.Fatalf("expected a known set to be sent, even when empty")
+			}
+
+			got, diags := SliceToStringSet(ctx, slice)
+			if diags.HasError() {
+				t.Fatalf("SliceToStringSet: %v", diags)
+			}
+
+			if !got.Equal(set) {
+				t.Errorf("expected %s, got %s", set, got)
+			}
+		})
+	}
+}
+
+// The sets of unkey_key_roles and unkey_key_permissions are required, so a
+// key without roles or permissions is read back as an empty set.
+func TestStringSetNull(t *testing.T) {
+	ctx := context.Background()
+
+	for _, set := range []types.Set{types.SetNull(types.StringType), types.SetUnknown(types.StringType)} {
+		slice, diags := StringSetToSlice(ctx, set)
+		if diags.HasError() {
+			t.Fatalf("StringSetToSlice: %v", diags)
+		}
+		if slice != nil {
+			t.Errorf("expected %s not to be sent, got %v", set, slice)
+		}
+	}
+
+	got, diags := SliceToStringSet(ctx, nil)
+	if diags.HasError() {
+		t.Fatalf("SliceToStringSet: %v", diags)
+	}
+
+	if !got.Equal(stringSet()) {
+		t.Errorf("expected an empty set, got %s", got)
+	}
+}
+
+func TestStringSetRoundTripProperty(t *testing.T) {
+	ctx := context.Background()
+
+	roundTrip := func(values []string) bool {
+		slices.Sort(values)
+		set := stringSet(slices.Compact(values)...)
+
+		slice, diags := StringSetToSlice(ctx, set)
+		if diags.HasError() {
+			return false
+		}
+
+		got, diags := SliceToStringSet(ctx, slice)
+		if diags.HasError() {
+			return false
+		}
+
+		return got.Equal(set)
+	}
+
+	if err := quick.Check(roundTrip, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMetaRoundTrip(t *testing.T) {
+	// Terraform's jsonencode writes compact JSON with sorted keys, which is
+	// also how meta is written back
+	tests := map[string]types.String{
+		"null":          types.StringNull(),
+		"flat":          types.StringValue(`{"plan":"free"}`),
+		"nested":        types.StringValue(`{"limits":{"seats":5,"tags":["a","b"]},"trial":true}`),
+		"null value":    types.StringValue(`{"deleted_at":null}`),
+		"float":         types.StringValue(`{"ratio":0.25}`),
+		"large integer": types.StringValue(`{"account":12345678901234567890}`),
+		"escaped":       types.StringValue(`{"html":"\u003cb\u003e \u0026"}`),
+	}
+
+	for name, meta := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			m, diags := StringToMap(ctx, meta)
+			if diags.HasError() {
+				t.Fatalf("StringToMap: %v", diags)
+			}
+
+			got, diags := MapToString(ctx, m)
+			if diags.HasError() {
+				t.Fatalf("MapToString: %v", diags)
+			}
+
+			if !got.Equal(meta) {
+				t.Errorf("expected %s, got %s", meta, got)
+			}
+		})
+	}
+}
+
+func TestStringToMap(t *testing.T) {
+	tests := map[string]struct {
+		meta    types.String
+		want    map[string]any
+		wantErr bool
+	}{
+		"unknown": {
+			meta: types.StringUnknown(),
+			want: nil,
+		},
+		"empty object": {
+			meta: types.StringValue(`{}`),
+			want: map[string]any{},
+		},
+		"whitespace": {
+			meta: types.StringValue(" {\n  \"plan\": \"free\"\n}\n"),
+			want: map[string]any{"plan": "free"},
+		},
+		"not an object": {
+			meta:    types.StringValue(`["free"]`),
+			wantErr: true,
+		},
+		"invalid": {
+			meta:    types.StringValue(`{"plan":`),
+			wantErr: true,
+		},
+		"trailing data": {
+			meta:    types.StringValue(`{"plan":"free"} {"plan":"pro"}`),
+			wantErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, diags := StringToMap(context.Background(), test.meta)
+
+			if diags.HasError() != test.wantErr {
+				t.Fatalf("expected error %t, got %v", test.wantErr, diags)
+			}
+
+			if !test.wantErr && !reflect.DeepEqual(got, test.want) {
+				t.Errorf("expected %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+// Unkey does not tell empty meta apart from no meta, so it is read back as
+// null.
+func TestMapToStringEmpty(t *testing.T) {
+	for _, m := range []map[string]any{nil, {}} {
+		got, diags := MapToString(context.Background(), m)
+		if diags.HasError() {
+			t.Fatalf("MapToString: %v", diags)
+		}
+
+		if !got.IsNull() {
+			t.Errorf("expected null for %#v, got %s", m, got)
+		}
+	}
+}
+
+func TestMetaFromAPI(t *testing.T) {
+	tests := map[string]struct {
+		body    string
+		want    types.String
+		wantErr bool
+	}{
+		"meta": {
+			body: `{"meta":{"requestId":"req_123"},"data":{"keyId":"key_123","meta":{"trial":true,"plan":"free"}}}`,
+			want: types.StringValue(`{"plan":"free","trial":true}`),
+		},
+		"large integer": {
+			body: `{"meta":{"requestId":"req_123"},"data":{"keyId":"key_123","meta":{"account":12345678901234567890}}}`,
+			want: types.StringValue(`{"account":12345678901234567890}`),
+		},
+		"float": {
+			body: `{"meta":{"requestId":"req_123"},"data":{"keyId":"key_123","meta":{"ratio":0.25}}}`,
+			want: types.StringValue(`{"ratio":0.25}`),
+		},
+		"no meta": {
+			body: `{"meta":{"requestId":"req_123"},"data":{"keyId":"key_123"}}`,
+			want: types.StringNull(),
+		},
+		"null meta": {
+			body: `{"meta":{"requestId":"req_123"},"data":{"keyId":"key_123","meta":null}}`,
+			want: types.StringNull(),
+		},
+		"invalid": {
+			body:    `{"data":`,
+			wantErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			response := &http.Response{Body: io.NopCloser(strings.NewReader(test.body))}
+
+			got, diags := MetaFromAPI(context.Background(), response)
+
+			if diags.HasError() != test.wantErr {
+				t.Fatalf("expected error %t, got %v", test.wantErr, diags)
+			}
+
+			if !test.wantErr && !got.Equal(test.want) {
+				t.Errorf("expected %s, got %s", test.want, got)
+			}
+		})
+	}
+}
+
+func TestMetaFromAPINoResponse(t *testing.T) {
+	if _, diags := MetaFromAPI(context.Background(), nil); !diags.HasError() {
+		t.Error("expected an error without a response")
+	}
+}
+
+// jsonObject is a random non-empty JSON object as written by jsonencode.
+type jsonObject string
+
+func (jsonObject) Generate(rand *rand.Rand, size int) reflect.Value {
+	object := map[string]any{"key": randomJSONValue(rand, 3)}
+	for i := 0; i < rand.Intn(size+1); i++ {
+		object[fmt.Sprintf("key%d", rand.Intn(size+1))] = randomJSONValue(rand, 3)
+	}
+
+	content, err := json.Marshal(object)
+	if err != nil {
+		panic(err)
+	}
+
+	return reflect.ValueOf(jsonObject(content))
+}
+
+func randomJSONValue(rand *rand.Rand, depth int) any {
+	kinds := 8
+	if depth == 0 {
+		kinds = 6
+	}
+
+	switch rand.Intn(kinds) {
+	case 0:
+		return nil
+	case 1:
+		return rand.Intn(2) == 0
+	case 2:
+		return rand.Int63() - rand.Int63()
+	case 3:
+		return rand.NormFloat64() * 1e6
+	case 4:
+		// Integers beyond the 2^53 float64 can hold exactly
+		return json.Number(fmt.Sprintf("%d%09d", rand.Int63(), rand.Intn(1e9)))
+	case 5:
+		value, _ := quick.Value(reflect.TypeOf(""), rand)
+		return value.String()
+	case 6:
+		values := make([]any, rand.Intn(4))
+		for i := range values {
+			values[i] = randomJSONValue(rand, depth-1)
+		}
+		return values
+	default:
+		object := map[string]any{}
+		for i := 0; i < rand.Intn(4); i++ {
+			object[fmt.Sprintf("field%d", i)] = randomJSONValue(rand, depth-1)
+		}
+		return object
+	}
+}
+
+func TestMetaRoundTripProperty(t *testing.T) {
+	ctx := context.Background()
+
+	roundTrip := func(object jsonObject) bool {
+		meta := types.StringValue(string(object))
+
+		m, diags := StringToMap(ctx, meta)
+		if diags.HasError() {
+			return false
+		}
+
+		got, diags := MapToString(ctx, m)
+		if diags.HasError() {
+			return false
+		}
+
+		return got.Equal(meta)
+	}
+
+	if err := quick.Check(roundTrip, nil); err != nil {
+		t.Error(err)
+	}
+}
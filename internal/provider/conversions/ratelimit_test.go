@@ -0,0 +1,136 @@
+package conversions
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"testing/quick"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unkeyed/sdks/api/go/v2/models/components"
+
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/models"
+)
+
+// ratelimit is a rate limit as generated by testing/quick.
+type ratelimit struct {
+	Name      string
+	Limit     int64
+	Duration  int64
+	AutoApply bool
+}
+
+func ratelimitsList(ratelimits ...ratelimit) types.List {
+	values := make([]attr.Value, len(ratelimits))
+	for i, rl := range ratelimits {
+		values[i] = types.ObjectValueMust(models.RatelimitAttrTypes, map[string]attr.Value{
+			"name":       types.StringValue(rl.Name),
+			"limit":      types.Int64Value(rl.Limit),
+			"duration":   types.Int64Value(rl.Duration),
+			"auto_apply": types.BoolValue(rl.AutoApply),
+		})
+	}
+
+	return types.ListValueMust(models.RatelimitObjectType, values)
+}
+
+// ratelimitsFromCreation returns the rate limits Unkey reports for the ones
+// a key or identity was created with.
+func ratelimitsFromCreation(ratelimits []components.RatelimitRequest) []components.RatelimitResponse {
+	var result []components.RatelimitResponse
+	for i, rl := range ratelimits {
+		result = append(result, components.RatelimitResponse{
+			ID:        fmt.Sprintf("rl_%d", i),
+			Name:      rl.Name,
+			Limit:     rl.Limit,
+			Duration:  rl.Duration,
+			AutoApply: rl.AutoApply != nil && *rl.AutoApply,
+		})
+	}
+
+	return result
+}
+
+func TestRatelimitsRoundTrip(t *testing.T) {
+	tests := map[string]types.List{
+		"null": types.ListNull(models.RatelimitObjectType),
+		"one": ratelimitsList(
+			ratelimit{Name: "requests", Limit: 10, Duration: 60000, AutoApply: true},
+		),
+		"several": ratelimitsList(
+			ratelimit{Name: "requests", Limit: 10, Duration: 1000, AutoApply: true},
+			ratelimit{Name: "tokens", Limit: 50000, Duration: 86400000, AutoApply: false},
+		),
+	}
+
+	for name, ratelimits := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			request, diags := RatelimitsToAPI(ctx, ratelimits)
+			if diags.HasError() {
+				t.Fatalf("RatelimitsToAPI: %v", diags)
+			}
+
+			got, diags := RatelimitsFromAPI(ctx, ratelimitsFromCreation(request))
+			if diags.HasError() {
+				t.Fatalf("RatelimitsFromAPI: %v", diags)
+			}
+
+			if !got.Equal(ratelimits) {
+				t.Errorf("expected %s, got %s", ratelimits, got)
+			}
+		})
+	}
+}
+
+func TestRatelimitsToAPIUnknown(t *testing.T) {
+	request, diags := RatelimitsToAPI(context.Background(), types.ListUnknown(models.RatelimitObjectType))
+	if diags.HasError() {
+		t.Fatalf("RatelimitsToAPI: %v", diags)
+	}
+
+	if request != nil {
+		t.Errorf("expected unknown rate limits not to be sent, got %s", toJSON(request))
+	}
+}
+
+// Unkey does not tell no rate limits apart from an empty list of them, so an
+// empty list is read back as null.
+func TestRatelimitsFromAPIEmpty(t *testing.T) {
+	got, diags := RatelimitsFromAPI(context.Background(), []components.RatelimitResponse{})
+	if diags.HasError() {
+		t.Fatalf("RatelimitsFromAPI: %v", diags)
+	}
+
+	if !got.IsNull() {
+		t.Errorf("expected null, got %s", got)
+	}
+}
+
+// TestRatelimitsRoundTripProperty checks the round trip for arbitrary
+// non-empty lists of rate limits.
+func TestRatelimitsRoundTripProperty(t *testing.T) {
+	ctx := context.Background()
+
+	roundTrip := func(first ratelimit, rest []ratelimit) bool {
+		ratelimits := ratelimitsList(append([]ratelimit{first}, rest...)...)
+
+		request, diags := RatelimitsToAPI(ctx, ratelimits)
+		if diags.HasError() {
+			return false
+		}
+
+		got, diags := RatelimitsFromAPI(ctx, ratelimitsFromCreation(request))
+		if diags.HasError() {
+			return false
+		}
+
+		return got.Equal(ratelimits)
+	}
+
+	if err := quick.Check(roundTrip, nil); err != nil {
+		t.Error(err)
+	}
+}
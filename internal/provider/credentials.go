@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// defaultProfile is the credentials file profile used when none is selected.
+const defaultProfile = "default"
+
+// resolveRootKey returns the root key together with a description of where it
+// was read from. Sources are tried in order: the root_key, root_key_file and
+// profile attributes, the UNKEY_ROOT_KEY and UNKEY_PROFILE environment
+// variables and finally the default profile of the credentials file. The
+// source is empty when none of them is set.
+func resolveRootKey(config unkeyProviderModel, diags *diag.Diagnostics) (string, string) {
+	switch {
+	case !config.RootKey.IsNull():
+		return config.RootKey.ValueString(), "the root_key attribute"
+
+	case !config.RootKeyFile.IsNull():
+		file := config.RootKeyFile.ValueString()
+		content, err := os.ReadFile(file)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("root_key_file"),
+				"Unable to Read Unkey Root Key File",
+				"The provider cannot create the Unkey API client as the root key file "+file+" could not be read: "+err.Error(),
+			)
+		}
+		return strings.TrimSpace(string(content)), "the root_key_file " + file
+
+	case !config.Profile.IsNull():
+		return profileRootKey(config.Profile.ValueString(), path.Root("profile"), diags)
+	}
+
+	if rootKey := os.Getenv("UNKEY_ROOT_KEY"); rootKey != "" {
+		return rootKey, "the UNKEY_ROOT_KEY environment variable"
+	}
+
+	if profile := os.Getenv("UNKEY_PROFILE"); profile != "" {
+		return profileRootKey(profile, path.Empty(), diags)
+	}
+
+	// The default profile is only a fallback, so a missing credentials
+	// file or profile is not an error
+	file, err := credentialsFile()
+	if err != nil {
+		return "", ""
+	}
+
+	rootKey, found, err := readProfile(file, defaultProfile)
+	if err != nil || !found {
+		return "", ""
+	}
+
+	return rootKey, "profile " + defaultProfile + " of " + file
+}
+
+// profileRootKey reads the root key of an explicitly selected profile, which
+// must exist in the credentials file.
+func profileRootKey(profile string, attribute path.Path, diags *diag.Diagnostics) (string, string) {
+	addError := func(summary, detail string) {
+		if attribute.Equal(path.Empty()) {
+			diags.AddError(summary, detail)
+		} else {
+			diags.AddAttributeError(attribute, summary, detail)
+		}
+	}
+
+	file, err := credentialsFile()
+	if err != nil {
+		addError(
+			"Unable to Locate Unkey Credentials File",
+			"The provider cannot read the Unkey API root key of profile "+profile+" as the credentials file could not be located: "+err.Error(),
+		)
+		return "", ""
+	}
+
+	source := "profile " + profile + " of " + file
+
+	rootKey, found, err := readProfile(file, profile)
+	if err != nil {
+		addError(
+			"Unable to Read Unkey Credentials File",
+			"The provider cannot read the Unkey API root key of profile "+profile+" as the credentials file "+file+" could not be read: "+err.Error(),
+		)
+		return "", source
+	}
+
+	if !found {
+		addError(
+			"Missing Unkey Profile",
+			"The credentials file "+file+" has no root_key for profile "+profile+". "+
+				"Add a ["+profile+"] section with a root_key entry, or select another profile.",
+		)
+	}
+
+	return rootKey, source
+}
+
+// credentialsFile returns the path of the credentials file, which is
+// ~/.unkey/credentials unless overridden by UNKEY_CREDENTIALS_FILE.
+func credentialsFile() (string, error) {
+	if file := os.Getenv("UNKEY_CREDENTIALS_FILE"); file != "" {
+		return file, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, ".unkey", "credentials"), nil
+}
+
+// readProfile returns the root_key of a profile in an INI style credentials
+// file, in which every profile is a [name] section:
+//
+//	[staging]
+//	root_key = unkey_...
+//
+// Lines starting with # or ; are comments, and the value may be quoted.
+func readProfile(file, profile string) (string, bool, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return "", false, err
+	}
+
+	section := ""
+	for _, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+
+		switch {
+		case line == "", strings.HasPrefix(line, "#"), strings.HasPrefix(line, ";"):
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			section = strings.TrimSpace(line[1 : len(line)-1])
+		case section == profile:
+			key, value, ok := strings.Cut(line, "=")
+			if ok && strings.TrimSpace(key) == "root_key" {
+				return unquote(strings.TrimSpace(value)), true, nil
+			}
+		}
+	}
+
+	return "", false, nil
+}
+
+// unquote removes the double or single quotes around a value.
+func unquote(value string) string {
+	for _, quote := range []string{`"`, "'"} {
+		if len(value) >= 2 && strings.HasPrefix(value, quote) && strings.HasSuffix(value, quote) {
+			return value[1 : len(value)-1]
+		}
+	}
+
+	return value
+}
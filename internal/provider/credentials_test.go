@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testCredentialsFile writes a credentials file and returns its path.
+func testCredentialsFile(t *testing.T, content string) string {
+	file := filepath.Join(t.TempDir(), "credentials")
+	if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return file
+}
+
+func TestReadProfile(t *testing.T) {
+	tests := map[string]struct {
+		content   string
+		profile   string
+		want      string
+		wantFound bool
+	}{
+		"default profile": {
+			content:   "[default]\nroot_key = unkey_default\n",
+			profile:   "default",
+			want:      "unkey_default",
+			wantFound: true,
+		},
+		"named profile": {
+			content:   "[default]\nroot_key = unkey_default\n\n[staging]\nroot_key = unkey_staging\n",
+			profile:   "staging",
+			want:      "unkey_staging",
+			wantFound: true,
+		},
+		"missing profile": {
+			content: "[default]\nroot_key = unkey_default\n",
+			profile: "staging",
+		},
+		"profile without root key": {
+			content: "[staging]\nbase_url = https://unkey.internal\n\n[default]\nroot_key = unkey_default\n",
+			profile: "staging",
+		},
+		"empty file": {
+			content: "",
+			profile: "default",
+		},
+		"comments": {
+			content:   "# root_key = unkey_commented\n[default]\n; root_key = unkey_commented\nroot_key = unkey_default\n",
+			profile:   "default",
+			want:      "unkey_default",
+			wantFound: true,
+		},
+		"whitespace": {
+			content:   "  [ staging ]  \r\n\troot_key\t=   unkey_staging  \r\n",
+			profile:   "staging",
+			want:      "unkey_staging",
+			wantFound: true,
+		},
+		"double quoted value": {
+			content:   "[default]\nroot_key = \"unkey_default\"\n",
+			profile:   "default",
+			want:      "unkey_default",
+			wantFound: true,
+		},
+		"single quoted value": {
+			content:   "[default]\nroot_key = 'unkey_default'\n",
+			profile:   "default",
+			want:      "unkey_default",
+			wantFound: true,
+		},
+		"first root key wins": {
+			content:   "[default]\nroot_key = unkey_first\nroot_key = unkey_second\n",
+			profile:   "default",
+			want:      "unkey_first",
+			wantFound: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, found, err := readProfile(testCredentialsFile(t, test.content), test.profile)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != test.want || found != test.wantFound {
+				t.Errorf("expected %q %t, got %q %t", test.want, test.wantFound, got, found)
+			}
+		})
+	}
+}
+
+func TestReadProfileMissingFile(t *testing.T) {
+	if _, _, err := readProfile(filepath.Join(t.TempDir(), "credentials"), "default"); err == nil {
+		t.Error("expected an error for a missing credentials file")
+	}
+}
+
+func TestResolveRootKey(t *testing.T) {
+	credentials := "[default]\nroot_key = unkey_default\n\n[staging]\nroot_key = unkey_staging\n"
+
+	rootKeyFile := filepath.Join(t.TempDir(), "root_key")
+	if err := os.WriteFile(rootKeyFile, []byte("  unkey_file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		config      unkeyProviderModel
+		env         map[string]string
+		credentials string
+		want        string
+		wantSource  string
+		wantErr     bool
+	}{
+		"root_key attribute": {
+			config:     unkeyProviderModel{RootKey: types.StringValue("unkey_attribute")},
+			env:        map[string]string{"UNKEY_ROOT_KEY": "unkey_env"},
+			want:       "unkey_attribute",
+			wantSource: "the root_key attribute",
+		},
+		"root_key_file attribute": {
+			config:     unkeyProviderModel{RootKeyFile: types.StringValue(rootKeyFile)},
+			env:        map[string]string{"UNKEY_ROOT_KEY": "unkey_env"},
+			want:       "unkey_file",
+			wantSource: "the root_key_file " + rootKeyFile,
+		},
+		"missing root_key_file": {
+			config:  unkeyProviderModel{RootKeyFile: types.StringValue(filepath.Join(t.TempDir(), "missing"))},
+			wantErr: true,
+		},
+		"profile attribute": {
+			config:      unkeyProviderModel{Profile: types.StringValue("staging")},
+			env:         map[string]string{"UNKEY_ROOT_KEY": "unkey_env"},
+			credentials: credentials,
+			want:        "unkey_staging",
+		},
+		"missing profile attribute": {
+			config:      unkeyProviderModel{Profile: types.StringValue("production")},
+			credentials: credentials,
+			wantErr:     true,
+		},
+		// The environment variable overrides the credentials file
+		"UNKEY_ROOT_KEY": {
+			env:         map[string]string{"UNKEY_ROOT_KEY": "unkey_env", "UNKEY_PROFILE": "staging"},
+			credentials: credentials,
+			want:        "unkey_env",
+			wantSource:  "the UNKEY_ROOT_KEY environment variable",
+		},
+		"UNKEY_PROFILE": {
+			env:         map[string]string{"UNKEY_PROFILE": "staging"},
+			credentials: credentials,
+			want:        "unkey_staging",
+		},
+		"missing UNKEY_PROFILE": {
+			env:         map[string]string{"UNKEY_PROFILE": "production"},
+			credentials: credentials,
+			wantErr:     true,
+		},
+		"default profile": {
+			credentials: credentials,
+			want:        "unkey_default",
+		},
+		// The default profile is only a fallback
+		"no default profile": {
+			credentials: "[staging]\nroot_key = unkey_staging\n",
+		},
+		"no credentials file": {},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("UNKEY_ROOT_KEY", "")
+			t.Setenv("UNKEY_PROFILE", "")
+			for name, value := range test.env {
+				t.Setenv(name, value)
+			}
+
+			file := filepath.Join(t.TempDir(), "credentials")
+			if test.credentials != "" {
+				file = testCredentialsFile(t, test.credentials)
+			}
+			t.Setenv("UNKEY_CREDENTIALS_FILE", file)
+
+			var diags diag.Diagnostics
+			got, source := resolveRootKey(test.config, &diags)
+
+			if diags.HasError() != test.wantErr {
+				t.Fatalf("expected error %t, got %v", test.wantErr, diags)
+			}
+			if got != test.want {
+				t.Errorf("expected root key %q, got %q", test.want, got)
+			}
+			if test.wantSource != "" && source != test.wantSource {
+				t.Errorf("expected source %q, got %q", test.wantSource, source)
+			}
+		})
+	}
+}
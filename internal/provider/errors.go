@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/unkeyed/sdks/api/go/v2/models/apierrors"
+)
+
+// errMissingCursor is returned when Unkey reports more pages of a list
+// without the cursor to fetch them, so the list would be incomplete.
+var errMissingCursor = errors.New("Unkey reported more results but sent no cursor to fetch them")
+
+// isNotFound reports whether err is Unkey telling us that the requested
+// resource no longer exists, as opposed to an authentication, validation or
+// server error that should still fail the operation.
+func isNotFound(err error) bool {
+	var notFound *apierrors.NotFoundErrorResponse
+	if errors.As(err, &notFound) {
+		return true
+	}
+
+	var gone *apierrors.GoneErrorResponse
+	if errors.As(err, &gone) {
+		return true
+	}
+
+	// Responses without a JSON body are surfaced as generic API errors
+	var apiErr *apierrors.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusNotFound || apiErr.StatusCode == http.StatusGone
+	}
+
+	return false
+}
+
+// isUnauthorized reports whether err is Unkey rejecting the root key itself.
+func isUnauthorized(err error) bool {
+	var unauthorized *apierrors.UnauthorizedErrorResponse
+	if errors.As(err, &unauthorized) {
+		return true
+	}
+
+	var apiErr *apierrors.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusUnauthorized
+	}
+
+	return false
+}
+
+// isForbidden reports whether err is Unkey accepting the root key but refusing
+// the operation because the root key lacks a permission.
+func isForbidden(err error) bool {
+	var forbidden *apierrors.ForbiddenErrorResponse
+	if errors.As(err, &forbidden) {
+		return true
+	}
+
+	var apiErr *apierrors.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusForbidden
+	}
+
+	return false
+}
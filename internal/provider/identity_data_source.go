@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/conversions"
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/models"
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unkeyed/sdks/api/go/v2/models/components"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &identityDataSource{}
+	_ datasource.DataSourceWithConfigure = &identityDataSource{}
+)
+
+// NewIdentityDataSource is a helper function to simplify the provider implementation.
+func NewIdentityDataSource() datasource.DataSource {
+	return &identityDataSource{}
+}
+
+// identityDataSource is the data source implementation.
+type identityDataSource struct {
+	client *unkeyClient
+}
+
+// Metadata returns the data source type name.
+func (d *identityDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity"
+}
+
+// Schema defines the schema for the data source.
+func (d *identityDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schemas.IdentityDataSourceSchema()
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *identityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get lookup values from configuration
+	var state models.IdentityDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.client.requestTimeout)
+	defer cancel()
+
+	// Unkey resolves both the identity ID and the external ID
+	lookup := state.IdentityId.ValueString()
+	if lookup == "" {
+		lookup = state.ExternalId.ValueString()
+	}
+
+	identity, err := d.client.Identities.GetIdentity(ctx, components.V2IdentitiesGetIdentityRequestBody{
+		Identity: lookup,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Unkey Identity",
+			"Could not read Unkey Identity "+lookup+": "+err.Error(),
+		)
+		return
+	}
+
+	data := identity.V2IdentitiesGetIdentityResponseBody.GetData()
+
+	// Map response body to model
+	state.IdentityId = types.StringValue(data.ID)
+	state.ExternalId = types.StringValue(data.ExternalID)
+
+	state.Meta, diags = conversions.MetaFromAPI(ctx, identity.HTTPMeta.Response)
+	resp.Diagnostics.Append(diags...)
+
+	state.Ratelimits, diags = conversions.RatelimitsFromAPI(ctx, data.Ratelimits)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keys can only be listed per API, filtered by the identity's external ID
+	if state.ApiId.IsNull() {
+		state.Keys = types.ListNull(types.StringType)
+	} else {
+		keyIds, err := d.listKeyIds(ctx, state.ApiId.ValueString(), data.ExternalID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to List Unkey Keys",
+				"Could not list keys of Unkey Identity "+data.ID+" in API ID "+state.ApiId.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+
+		state.Keys, diags = types.ListValueFrom(ctx, types.StringType, keyIds)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// listKeyIds returns the IDs of every key in the API that belongs to the
+// identity with the given external ID, following pagination cursors.
+func (d *identityDataSource) listKeyIds(ctx context.Context, apiId, externalId string) ([]string, error) {
+	keyIds := []string{}
+	limit := int64(100)
+
+	var cursor *string
+	for {
+		list, err := d.client.Apis.ListKeys(ctx, components.V2ApisListKeysRequestBody{
+			APIID:      apiId,
+			Limit:      &limit,
+			Cursor:     cursor,
+			ExternalID: &externalId,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		body := list.V2ApisListKeysResponseBody
+		for _, key := range body.GetData() {
+			keyIds = append(keyIds, key.KeyID)
+		}
+
+		pagination := body.GetPagination()
+		if !pagination.GetHasMore() {
+			return keyIds, nil
+		}
+		if pagination.GetCursor() == nil {
+			return nil, errMissingCursor
+		}
+		cursor = pagination.GetCursor()
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *identityDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unkeyClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.unkeyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/fakeunkey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIdentityDataSource(t *testing.T) {
+	server := fakeunkey.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityDataSourceConfig(server),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Looked up by ID
+					resource.TestCheckResourceAttrPair("data.unkey_identity.by_id", "id", "unkey_identity.test", "id"),
+					resource.TestCheckResourceAttr("data.unkey_identity.by_id", "external_id", "user_123"),
+					resource.TestCheckResourceAttr("data.unkey_identity.by_id", "meta", `{"plan":"free"}`),
+					resource.TestCheckResourceAttr("data.unkey_identity.by_id", "ratelimits.#", "1"),
+					resource.TestCheckResourceAttr("data.unkey_identity.by_id", "ratelimits.0.name", "requests"),
+					resource.TestCheckResourceAttr("data.unkey_identity.by_id", "ratelimits.0.limit", "10"),
+					resource.TestCheckNoResourceAttr("data.unkey_identity.by_id", "keys"),
+					// Looked up by external ID, with the keys of an API
+					resource.TestCheckResourceAttrPair("data.unkey_identity.by_external_id", "id", "unkey_identity.test", "id"),
+					resource.TestCheckResourceAttr("data.unkey_identity.by_external_id", "keys.#", "1"),
+					resource.TestCheckResourceAttrPair("data.unkey_identity.by_external_id", "keys.0", "unkey_key.test", "id"),
+				),
+			},
+			// An identity that does not exist fails the read
+			{
+				Config:      testAccIdentityDataSourceMissingConfig(server),
+				ExpectError: regexp.MustCompile("Unable to Read Unkey Identity"),
+			},
+		},
+	})
+}
+
+func testAccIdentityDataSourceConfig(server *fakeunkey.Server) string {
+	return testAccProviderConfig(server) + `
+resource "unkey_api" "test" {
+  name = "payments"
+}
+
+resource "unkey_identity" "test" {
+  external_id = "user_123"
+  meta        = jsonencode({ plan = "free" })
+
+  ratelimits = [
+    {
+      name       = "requests"
+      limit      = 10
+      duration   = 60000
+      auto_apply = true
+    },
+  ]
+}
+
+resource "unkey_key" "test" {
+  api_id      = unkey_api.test.id
+  external_id = unkey_identity.test.external_id
+}
+
+resource "unkey_key" "other" {
+  api_id = unkey_api.test.id
+}
+
+data "unkey_identity" "by_id" {
+  id = unkey_identity.test.id
+}
+
+data "unkey_identity" "by_external_id" {
+  external_id = unkey_identity.test.external_id
+  api_id      = unkey_api.test.id
+
+  depends_on = [unkey_key.test, unkey_key.other]
+}
+`
+}
+
+func testAccIdentityDataSourceMissingConfig(server *fakeunkey.Server) string {
+	return testAccProviderConfig(server) + `
+data "unkey_identity" "test" {
+  external_id = "user_missing"
+}
+`
+}
@@ -9,16 +9,18 @@ import (
 	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/conversions"
 	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/models"
 	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	unkey "github.com/unkeyed/sdks/api/go/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/unkeyed/sdks/api/go/v2/models/components"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &identityResource{}
-	_ resource.ResourceWithConfigure = &identityResource{}
+	_ resource.Resource                = &identityResource{}
+	_ resource.ResourceWithConfigure   = &identityResource{}
+	_ resource.ResourceWithImportState = &identityResource{}
 )
 
 // NewIdentityResource is a helper function to simplify the provider implementation.
@@ -28,7 +30,7 @@ func NewIdentityResource() resource.Resource {
 
 // identityResource is the resource implementation.
 type identityResource struct {
-	client *unkey.Unkey
+	client *unkeyClient
 }
 
 // Metadata returns the resource type name.
@@ -37,8 +39,8 @@ func (r *identityResource) Metadata(_ context.Context, req resource.MetadataRequ
 }
 
 // Schema defines the schema for the resource.
-func (r *identityResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schemas.IdentitySchema()
+func (r *identityResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schemas.IdentitySchema(ctx)
 }
 
 // Create a new resource.
@@ -51,6 +53,15 @@ func (r *identityResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.client.requestTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	request := components.V2IdentitiesCreateIdentityRequestBody{
 		ExternalID: plan.ExternalId.ValueString(),
 	}
@@ -94,11 +105,29 @@ func (r *identityResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, r.client.requestTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed Identity from Unkey
 	identity, err := r.client.Identities.GetIdentity(ctx, components.V2IdentitiesGetIdentityRequestBody{
 		Identity: state.IdentityId.ValueString(),
 	})
 	if err != nil {
+		// The Identity was deleted outside of Terraform, plan to recreate it
+		if isNotFound(err) {
+			tflog.Warn(ctx, "Unkey Identity not found, removing from state", map[string]any{
+				"id": state.IdentityId.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error Reading Unkey Identity",
 			"Could not read Unkey Identity ID "+state.IdentityId.ValueString()+": "+err.Error(),
@@ -109,9 +138,10 @@ func (r *identityResource) Read(ctx context.Context, req resource.ReadRequest, r
 	data := identity.V2IdentitiesGetIdentityResponseBody.GetData()
 
 	// Overwrite items with refreshed state
+	state.IdentityId = types.StringValue(data.ID)
 	state.ExternalId = types.StringValue(data.ExternalID)
 
-	state.Meta, diags = conversions.MapToString(ctx, data.Meta)
+	state.Meta, diags = conversions.MetaFromAPI(ctx, identity.HTTPMeta.Response)
 	resp.Diagnostics.Append(diags...)
 
 	state.Ratelimits, diags = conversions.RatelimitsFromAPI(ctx, data.Ratelimits)
@@ -135,6 +165,15 @@ func (r *identityResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.client.requestTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	identityId := state.IdentityId.ValueString()
 
 	// Build update request - only include fields that can be updated
@@ -179,7 +218,7 @@ func (r *identityResource) Update(ctx context.Context, req resource.UpdateReques
 	// Update state with API response
 	plan.ExternalId = types.StringValue(data.ExternalID)
 
-	state.Meta, diags = conversions.MapToString(ctx, data.Meta)
+	state.Meta, diags = conversions.MetaFromAPI(ctx, identity.HTTPMeta.Response)
 	resp.Diagnostics.Append(diags...)
 
 	state.Ratelimits, diags = conversions.RatelimitsFromAPI(ctx, data.Ratelimits)
@@ -201,11 +240,25 @@ func (r *identityResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.client.requestTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete existing API
 	_, err := r.client.Identities.DeleteIdentity(ctx, components.V2IdentitiesDeleteIdentityRequestBody{
 		Identity: state.IdentityId.ValueString(),
 	})
 	if err != nil {
+		// Nothing left to delete
+		if isNotFound(err) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error Deleting Unkey Identity",
 			"Could not delete Identity, unexpected error: "+err.Error(),
@@ -214,6 +267,12 @@ func (r *identityResource) Delete(ctx context.Context, req resource.DeleteReques
 	}
 }
 
+// ImportState imports an identity by its ID or by its external ID, both of
+// which are accepted by Unkey when reading the identity.
+func (r *identityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *identityResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Add a nil check when handling ProviderData because Terraform
@@ -222,12 +281,12 @@ func (r *identityResource) Configure(_ context.Context, req resource.ConfigureRe
 		return
 	}
 
-	client, ok := req.ProviderData.(*unkey.Unkey)
+	client, ok := req.ProviderData.(*unkeyClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *unkey.Unkey, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.unkeyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/fakeunkey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccIdentityResource(t *testing.T) {
+	server := fakeunkey.NewServer(t)
+
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(server, "unkey_identity"),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccIdentityResourceConfig(server, "free", 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unkey_identity.test", "external_id", "user_123"),
+					resource.TestCheckResourceAttr("unkey_identity.test", "meta", `{"plan":"free"}`),
+					resource.TestCheckResourceAttr("unkey_identity.test", "ratelimits.#", "1"),
+					resource.TestCheckResourceAttr("unkey_identity.test", "ratelimits.0.limit", "10"),
+					resource.TestCheckResourceAttrSet("unkey_identity.test", "id"),
+					testAccCheckID("unkey_identity.test", &id),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "unkey_identity.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccIdentityResourceConfig(server, "pro", 100),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("unkey_identity.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unkey_identity.test", "meta", `{"plan":"pro"}`),
+					resource.TestCheckResourceAttr("unkey_identity.test", "ratelimits.0.limit", "100"),
+					resource.TestCheckResourceAttrPtr("unkey_identity.test", "id", &id),
+				),
+			},
+			// Drift testing: the identity is recreated after being deleted outside of Terraform
+			{
+				PreConfig: testAccDelete(t, server, &id),
+				Config:    testAccIdentityResourceConfig(server, "pro", 100),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("unkey_identity.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccIdentityResourceConfig(server *fakeunkey.Server, plan string, limit int) string {
+	return testAccProviderConfig(server) + fmt.Sprintf(`
+resource "unkey_identity" "test" {
+  external_id = "user_123"
+  meta        = jsonencode({ plan = %q })
+
+  ratelimits = [
+    {
+      name       = "requests"
+      limit      = %d
+      duration   = 60000
+      auto_apply = true
+    },
+  ]
+}
+`, plan, limit)
+}
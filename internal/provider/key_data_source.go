@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/conversions"
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/models"
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unkeyed/sdks/api/go/v2/models/components"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &keyDataSource{}
+	_ datasource.DataSourceWithConfigure = &keyDataSource{}
+)
+
+// NewKeyDataSource is a helper function to simplify the provider implementation.
+func NewKeyDataSource() datasource.DataSource {
+	return &keyDataSource{}
+}
+
+// keyDataSource is the data source implementation.
+type keyDataSource struct {
+	client *unkeyClient
+}
+
+// Metadata returns the data source type name.
+func (d *keyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_key"
+}
+
+// Schema defines the schema for the data source.
+func (d *keyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schemas.KeyDataSourceSchema()
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *keyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get lookup values from configuration
+	var state models.KeyDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.client.requestTimeout)
+	defer cancel()
+
+	key, err := d.client.Keys.GetKey(ctx, components.V2KeysGetKeyRequestBody{
+		KeyID: state.KeyId.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Unkey Key",
+			"Could not read Unkey Key ID "+state.KeyId.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	data := key.V2KeysGetKeyResponseBody.GetData()
+
+	// Map response body to model
+	state.KeyId = types.StringValue(data.KeyID)
+	state.Start = types.StringValue(data.Start)
+	state.Name = types.StringPointerValue(data.Name)
+	state.Enabled = types.BoolValue(data.Enabled)
+	state.Expires = types.Int64PointerValue(data.Expires)
+
+	if data.Identity != nil {
+		state.ExternalId = types.StringValue(data.Identity.ExternalID)
+	} else {
+		state.ExternalId = types.StringNull()
+	}
+
+	state.Permissions, diags = conversions.SliceToStringList(ctx, data.Permissions)
+	resp.Diagnostics.Append(diags...)
+
+	state.Roles, diags = conversions.SliceToStringList(ctx, data.Roles)
+	resp.Diagnostics.Append(diags...)
+
+	state.Meta, diags = conversions.MetaFromAPI(ctx, key.HTTPMeta.Response)
+	resp.Diagnostics.Append(diags...)
+
+	state.Credits, diags = conversions.CreditsFromAPI(ctx, data.Credits)
+	resp.Diagnostics.Append(diags...)
+
+	state.Ratelimits, diags = conversions.RatelimitsFromAPI(ctx, data.Ratelimits)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *keyDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unkeyClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.unkeyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/fakeunkey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccKeyDataSource(t *testing.T) {
+	server := fakeunkey.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyDataSourceConfig(server),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.unkey_key.test", "id", "unkey_key.test", "id"),
+					resource.TestCheckResourceAttrPair("data.unkey_key.test", "start", "unkey_key.test", "start"),
+					resource.TestCheckResourceAttr("data.unkey_key.test", "name", "billing"),
+					resource.TestCheckResourceAttr("data.unkey_key.test", "external_id", "user_123"),
+					resource.TestCheckResourceAttr("data.unkey_key.test", "meta", `{"plan":"free"}`),
+					resource.TestCheckResourceAttr("data.unkey_key.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("data.unkey_key.test", "roles.#", "1"),
+					resource.TestCheckResourceAttr("data.unkey_key.test", "roles.0", "reader"),
+					resource.TestCheckResourceAttr("data.unkey_key.test", "permissions.#", "1"),
+					resource.TestCheckResourceAttr("data.unkey_key.test", "permissions.0", "documents.read"),
+					resource.TestCheckResourceAttr("data.unkey_key.test", "credits.remaining", "100"),
+					resource.TestCheckResourceAttr("data.unkey_key.test", "credits.refill.interval", "daily"),
+					resource.TestCheckResourceAttr("data.unkey_key.test", "credits.refill.amount", "100"),
+					resource.TestCheckResourceAttr("data.unkey_key.test", "ratelimits.#", "1"),
+					resource.TestCheckResourceAttr("data.unkey_key.test", "ratelimits.0.name", "requests"),
+					resource.TestCheckResourceAttr("data.unkey_key.test", "ratelimits.0.limit", "10"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKeyDataSourceConfig(server *fakeunkey.Server) string {
+	return testAccProviderConfig(server) + `
+resource "unkey_api" "test" {
+  name = "payments"
+}
+
+resource "unkey_role" "reader" {
+  name = "reader"
+}
+
+resource "unkey_key" "test" {
+  api_id      = unkey_api.test.id
+  name        = "billing"
+  external_id = "user_123"
+  meta        = jsonencode({ plan = "free" })
+  roles       = [unkey_role.reader.name]
+  permissions = ["documents.read"]
+
+  credits = {
+    remaining = 100
+    refill = {
+      interval = "daily"
+      amount   = 100
+    }
+  }
+
+  ratelimits = [
+    {
+      name       = "requests"
+      limit      = 10
+      duration   = 60000
+      auto_apply = true
+    },
+  ]
+}
+
+data "unkey_key" "test" {
+  id = unkey_key.test.id
+}
+`
+}
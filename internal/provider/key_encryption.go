@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"filippo.io/age"
+	"filippo.io/age/armor"
+	"github.com/ProtonMail/go-crypto/openpgp"
+	pgparmor "github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// keyRecipient encrypts keys for a PGP public key or an age recipient, so
+// only the ciphertext has to be written to the state.
+type keyRecipient interface {
+	// Fingerprint identifies the public key the key is encrypted for.
+	Fingerprint() string
+	// Encrypt returns the ASCII-armored ciphertext of the key.
+	Encrypt(plaintext string) (string, error)
+}
+
+// parseKeyRecipient returns the recipient configured by pgp_key or
+// age_recipient, or nil when neither is set.
+func parseKeyRecipient(pgpKey, ageRecipient types.String) (keyRecipient, error) {
+	switch {
+	case !pgpKey.IsNull() && !pgpKey.IsUnknown():
+		return parsePGPRecipient(pgpKey.ValueString())
+	case !ageRecipient.IsNull() && !ageRecipient.IsUnknown():
+		return parseAgeRecipient(ageRecipient.ValueString())
+	default:
+		return nil, nil
+	}
+}
+
+// parseAgeRecipient reads an age X25519 public key.
+func parseAgeRecipient(publicKey string) (keyRecipient, error) {
+	recipient, err := age.ParseX25519Recipient(strings.TrimSpace(publicKey))
+	if err != nil {
+		return nil, err
+	}
+
+	return ageKeyRecipient{recipient}, nil
+}
+
+// parsePGPRecipient reads an ASCII-armored public key, or a base64-encoded
+// binary one as produced by filebase64.
+func parsePGPRecipient(publicKey string) (keyRecipient, error) {
+	var entities openpgp.EntityList
+	var err error
+
+	if strings.Contains(publicKey, "-----BEGIN PGP PUBLIC KEY BLOCK-----") {
+		entities, err = openpgp.ReadArmoredKeyRing(strings.NewReader(publicKey))
+	} else {
+		var binary []byte
+		binary, err = base64.StdEncoding.DecodeString(strings.TrimSpace(publicKey))
+		if err != nil {
+			return nil, errors.New("the key is neither ASCII-armored nor valid base64")
+		}
+		entities, err = openpgp.ReadKeyRing(bytes.NewReader(binary))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(entities) != 1 {
+		return nil, fmt.Errorf("expected a single PGP public key, got %d", len(entities))
+	}
+
+	// Signing-only, expired and revoked keys would only fail once the key
+	// has been created
+	if _, ok := entities[0].EncryptionKey(time.Now()); !ok {
+		return nil, errors.New("the PGP public key has no valid encryption subkey")
+	}
+
+	return pgpKeyRecipient{entities[0]}, nil
+}
+
+type pgpKeyRecipient struct {
+	entity *openpgp.Entity
+}
+
+func (r pgpKeyRecipient) Fingerprint() string {
+	return strings.ToUpper(hex.EncodeToString(r.entity.PrimaryKey.Fingerprint))
+}
+
+func (r pgpKeyRecipient) Encrypt(plaintext string) (string, error) {
+	var ciphertext strings.Builder
+
+	armored, err := pgparmor.Encode(&ciphertext, "PGP MESSAGE", nil)
+	if err != nil {
+		return "", err
+	}
+
+	encrypted, err := openpgp.Encrypt(armored, []*openpgp.Entity{r.entity}, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+
+	if err := writeAndClose(encrypted, plaintext); err != nil {
+		return "", err
+	}
+	if err := armored.Close(); err != nil {
+		return "", err
+	}
+
+	return ciphertext.String(), nil
+}
+
+type ageKeyRecipient struct {
+	recipient *age.X25519Recipient
+}
+
+// Fingerprint returns the recipient itself, as age public keys are short
+// enough to be compared directly.
+func (r ageKeyRecipient) Fingerprint() string {
+	return r.recipient.String()
+}
+
+func (r ageKeyRecipient) Encrypt(plaintext string) (string, error) {
+	var ciphertext strings.Builder
+
+	armored := armor.NewWriter(&ciphertext)
+
+	encrypted, err := age.Encrypt(armored, r.recipient)
+	if err != nil {
+		return "", err
+	}
+
+	if err := writeAndClose(encrypted, plaintext); err != nil {
+		return "", err
+	}
+	if err := armored.Close(); err != nil {
+		return "", err
+	}
+
+	return ciphertext.String(), nil
+}
+
+func writeAndClose(w io.WriteCloser, plaintext string) error {
+	if _, err := io.WriteString(w, plaintext); err != nil {
+		return err
+	}
+
+	return w.Close()
+}
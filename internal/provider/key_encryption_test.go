@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"bytes"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+)
+
+func TestParsePGPRecipient(t *testing.T) {
+	entity, err := openpgp.NewEntity("Billing", "", "billing@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signing, err := openpgp.NewEntity("Signing", "", "signing@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signing.Subkeys = nil
+
+	var binary, keyring bytes.Buffer
+	if err := entity.Serialize(&binary); err != nil {
+		t.Fatal(err)
+	}
+	keyring.Write(binary.Bytes())
+	if err := signing.Serialize(&keyring); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		publicKey string
+		wantErr   string
+	}{
+		"armored": {
+			publicKey: testPGPPublicKey(t, entity),
+		},
+		"base64": {
+			publicKey: base64.StdEncoding.EncodeToString(binary.Bytes()),
+		},
+		"signing only": {
+			publicKey: testPGPPublicKey(t, signing),
+			wantErr:   "no valid encryption subkey",
+		},
+		"several keys": {
+			publicKey: base64.StdEncoding.EncodeToString(keyring.Bytes()),
+			wantErr:   "expected a single PGP public key, got 2",
+		},
+		"not a key": {
+			publicKey: "keybase:billing",
+			wantErr:   "neither ASCII-armored nor valid base64",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			recipient, err := parsePGPRecipient(test.publicKey)
+
+			if test.wantErr == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got %s", err)
+				}
+				if _, err := recipient.Encrypt("pay_123"); err != nil {
+					t.Errorf("expected the key to encrypt, got %s", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Errorf("expected an error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
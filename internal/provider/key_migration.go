@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/unkeyed/sdks/api/go/v2/models/apierrors"
+	"github.com/unkeyed/sdks/api/go/v2/models/components"
+)
+
+// migrateKeysRequest is the body of keys.migrateKeys, which the Unkey SDK has
+// no operation for.
+type migrateKeysRequest struct {
+	MigrationID string           `json:"migrationId"`
+	APIID       string           `json:"apiId"`
+	Keys        []migrateKeyData `json:"keys"`
+}
+
+// migrateKeyData is a key to migrate, identified by its hash. It takes the
+// same settings as a key that Unkey generates.
+type migrateKeyData struct {
+	Hash        string                        `json:"hash"`
+	Prefix      *string                       `json:"prefix,omitempty"`
+	Name        *string                       `json:"name,omitempty"`
+	ExternalID  *string                       `json:"externalId,omitempty"`
+	Meta        map[string]any                `json:"meta,omitempty"`
+	Roles       []string                      `json:"roles,omitempty"`
+	Permissions []string                      `json:"permissions,omitempty"`
+	Expires     *int64                        `json:"expires,omitempty"`
+	Enabled     *bool                         `json:"enabled,omitempty"`
+	Credits     *components.KeyCreditsData    `json:"credits,omitempty"`
+	Ratelimits  []components.RatelimitRequest `json:"ratelimits,omitempty"`
+}
+
+type migrateKeysResponse struct {
+	Data struct {
+		Migrated []struct {
+			Hash  string `json:"hash"`
+			KeyID string `json:"keyId"`
+		} `json:"migrated"`
+		Failed []struct {
+			Hash  string `json:"hash"`
+			Error string `json:"error"`
+		} `json:"failed"`
+	} `json:"data"`
+}
+
+// hashKey returns the hash Unkey stores for a key: the base64 encoded SHA-256
+// of the key.
+func hashKey(plaintext string) string {
+	sum := sha256.Sum256([]byte(plaintext))
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// migrateKey adds an existing key to Unkey with the settings of the create
+// request and returns its ID. Only the hash of the key is sent, so Unkey
+// verifies the key without ever receiving it.
+func migrateKey(ctx context.Context, client *unkeyClient, migrationId, plaintext string, request components.V2KeysCreateKeyRequestBody) (string, error) {
+	hash := hashKey(plaintext)
+
+	body, err := json.Marshal(migrateKeysRequest{
+		MigrationID: migrationId,
+		APIID:       request.APIID,
+		Keys: []migrateKeyData{{
+			Hash:        hash,
+			Prefix:      request.Prefix,
+			Name:        request.Name,
+			ExternalID:  request.ExternalID,
+			Meta:        request.Meta,
+			Roles:       request.Roles,
+			Permissions: request.Permissions,
+			Expires:     request.Expires,
+			Enabled:     request.Enabled,
+			Credits:     request.Credits,
+			Ratelimits:  request.Ratelimits,
+		}},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, client.serverURL+"/v2/keys.migrateKeys", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+client.rootKey)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	// Errors are reported like the SDK does, so they are classified the same
+	if resp.StatusCode >= http.StatusBadRequest {
+		return "", apierrors.NewAPIError("API error occurred", resp.StatusCode, string(raw), resp)
+	}
+
+	var result migrateKeysResponse
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return "", err
+	}
+
+	for _, failed := range result.Data.Failed {
+		if failed.Hash == hash {
+			return "", errors.New(failed.Error)
+		}
+	}
+	for _, migrated := range result.Data.Migrated {
+		if migrated.Hash == hash {
+			return migrated.KeyID, nil
+		}
+	}
+
+	return "", errors.New("Unkey did not report the key as migrated")
+}
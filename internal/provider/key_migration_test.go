@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/fakeunkey"
+	unkey "github.com/unkeyed/sdks/api/go/v2"
+	"github.com/unkeyed/sdks/api/go/v2/models/components"
+	"github.com/unkeyed/sdks/api/go/v2/retry"
+)
+
+func testMigrationClient(server *fakeunkey.Server, rootKey string) *unkeyClient {
+	return &unkeyClient{
+		Unkey: unkey.New(
+			unkey.WithServerURL(server.URL),
+			unkey.WithSecurity(rootKey),
+			unkey.WithRetryConfig(retry.Config{Strategy: "none"}),
+		),
+		requestTimeout: 10 * time.Second,
+		httpClient:     http.DefaultClient,
+		serverURL:      server.URL,
+		rootKey:        rootKey,
+	}
+}
+
+func TestMigrateKey(t *testing.T) {
+	ctx := context.Background()
+	server := fakeunkey.NewServer(t)
+
+	api, err := testMigrationClient(server, fakeunkey.RootKey).Apis.CreateAPI(ctx, components.V2ApisCreateAPIRequestBody{
+		Name: "payments",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	apiId := api.V2ApisCreateAPIResponseBody.Data.APIID
+
+	name := "migrated"
+	request := components.V2KeysCreateKeyRequestBody{
+		APIID: apiId,
+		Name:  &name,
+	}
+
+	keyId, err := migrateKey(ctx, testMigrationClient(server, fakeunkey.RootKey), "terraform", "sk_live_existing", request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := server.KeyHash(keyId), hashKey("sk_live_existing"); got != want {
+		t.Errorf("expected Unkey to store the hash %s, got %q", want, got)
+	}
+
+	tests := map[string]struct {
+		rootKey   string
+		plaintext string
+		apiId     string
+		check     func(error) bool
+	}{
+		// Unkey already knows the hash of the key
+		"already migrated": {
+			rootKey:   fakeunkey.RootKey,
+			plaintext: "sk_live_existing",
+			apiId:     apiId,
+			check:     func(err error) bool { return err != nil && err.Error() == "a key with this hash already exists" },
+		},
+		"missing API": {
+			rootKey:   fakeunkey.RootKey,
+			plaintext: "sk_live_other",
+			apiId:     "api_missing",
+			check:     isNotFound,
+		},
+		"rejected root key": {
+			rootKey:   "unkey_revoked_root_key",
+			plaintext: "sk_live_other",
+			apiId:     apiId,
+			check:     isUnauthorized,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			request := request
+			request.APIID = test.apiId
+
+			keyId, err := migrateKey(ctx, testMigrationClient(server, test.rootKey), "terraform", test.plaintext, request)
+			if !test.check(err) {
+				t.Errorf("unexpected error %v", err)
+			}
+			if keyId != "" {
+				t.Errorf("expected no key, got %s", keyId)
+			}
+		})
+	}
+}
+
+func TestHashKey(t *testing.T) {
+	// echo -n unkey_3ZH8hqqDfJbDUoWXLrWBZkN9 | openssl dgst -sha256 -binary | base64
+	if got, want := hashKey("unkey_3ZH8hqqDfJbDUoWXLrWBZkN9"), "zHPKUtSJ4s5TkAnKRvkhdqaFi00QZFY3uwio3DxBjs4="; got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
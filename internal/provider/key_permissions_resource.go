@@ -0,0 +1,377 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/conversions"
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/models"
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/unkeyed/sdks/api/go/v2/models/components"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &keyPermissionsResource{}
+	_ resource.ResourceWithConfigure   = &keyPermissionsResource{}
+	_ resource.ResourceWithImportState = &keyPermissionsResource{}
+	_ resource.ResourceWithModifyPlan  = &keyPermissionsResource{}
+)
+
+// NewKeyPermissionsResource is a helper function to simplify the provider implementation.
+func NewKeyPermissionsResource() resource.Resource {
+	return &keyPermissionsResource{}
+}
+
+// keyPermissionsResource is the resource implementation.
+type keyPermissionsResource struct {
+	client *unkeyClient
+}
+
+// Metadata returns the resource type name.
+func (r *keyPermissionsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_key_permissions"
+}
+
+// Schema defines the schema for the resource.
+func (r *keyPermissionsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schemas.KeyPermissionsSchema(ctx)
+}
+
+// Create a new resource.
+func (r *keyPermissionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan models.KeyPermissionsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.client.requestTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	keyId := plan.KeyId.ValueString()
+
+	permissions, diags := conversions.StringSetToSlice(ctx, plan.Permissions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var err error
+	if plan.Authoritative.ValueBool() {
+		_, err = r.client.Keys.SetPermissions(ctx, components.V2KeysSetPermissionsRequestBody{
+			KeyID:       keyId,
+			Permissions: permissions,
+		})
+	} else if len(permissions) > 0 {
+		_, err = r.client.Keys.AddPermissions(ctx, components.V2KeysAddPermissionsRequestBody{
+			KeyID:       keyId,
+			Permissions: permissions,
+		})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Granting Unkey Key Permissions",
+			"Could not grant permissions to Unkey Key ID "+keyId+": "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.Id = types.StringValue(keyId)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *keyPermissionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state models.KeyPermissionsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, r.client.requestTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed key from Unkey
+	key, err := r.client.Keys.GetKey(ctx, components.V2KeysGetKeyRequestBody{
+		KeyID: state.KeyId.ValueString(),
+	})
+	if err != nil {
+		// The key was deleted outside of Terraform, and its permissions with it
+		if isNotFound(err) {
+			tflog.Warn(ctx, "Unkey Key not found, removing key permissions from state", map[string]any{
+				"key_id": state.KeyId.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Unkey Key Permissions",
+			"Could not read permissions of Unkey Key ID "+state.KeyId.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	permissions := key.V2KeysGetKeyResponseBody.GetData().Permissions
+
+	// In additive mode only the permissions declared by this resource are tracked
+	if !state.Authoritative.ValueBool() {
+		managed, diags := conversions.StringSetToSlice(ctx, state.Permissions)
+		resp.Diagnostics.Append(diags...)
+		permissions = intersection(managed, permissions)
+	}
+
+	// Overwrite items with refreshed state
+	state.Id = types.StringValue(state.KeyId.ValueString())
+
+	state.Permissions, diags = conversions.SliceToStringSet(ctx, permissions)
+	resp.Diagnostics.Append(diags...)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *keyPermissionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get current state and plan
+	var state, plan models.KeyPermissionsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.client.requestTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	keyId := plan.KeyId.ValueString()
+
+	planned, diags := conversions.StringSetToSlice(ctx, plan.Permissions)
+	resp.Diagnostics.Append(diags...)
+
+	current, diags := conversions.StringSetToSlice(ctx, state.Permissions)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A new key, such as one rotated by unkey_key, needs every planned permission
+	previousKeyId := state.KeyId.ValueString()
+	assigned := current
+	if previousKeyId != keyId {
+		assigned = nil
+	}
+
+	if plan.Authoritative.ValueBool() {
+		_, err := r.client.Keys.SetPermissions(ctx, components.V2KeysSetPermissionsRequestBody{
+			KeyID:       keyId,
+			Permissions: planned,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Granting Unkey Key Permissions",
+				"Could not set permissions of Unkey Key ID "+keyId+": "+err.Error(),
+			)
+			return
+		}
+	} else {
+		if added := difference(planned, assigned); len(added) > 0 {
+			_, err := r.client.Keys.AddPermissions(ctx, components.V2KeysAddPermissionsRequestBody{
+				KeyID:       keyId,
+				Permissions: added,
+			})
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error Granting Unkey Key Permissions",
+					"Could not add permissions to Unkey Key ID "+keyId+": "+err.Error(),
+				)
+				return
+			}
+		}
+
+		// Permissions tracked in authoritative mode may have been granted by
+		// someone else, so only revoke permissions this resource added itself
+		if removed := difference(assigned, planned); len(removed) > 0 && !state.Authoritative.ValueBool() {
+			_, err := r.client.Keys.RemovePermissions(ctx, components.V2KeysRemovePermissionsRequestBody{
+				KeyID:       keyId,
+				Permissions: removed,
+			})
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error Revoking Unkey Key Permissions",
+					"Could not remove permissions from Unkey Key ID "+keyId+": "+err.Error(),
+				)
+				return
+			}
+		}
+	}
+
+	// A rotated key keeps its permissions until its overlap ends, any other
+	// previous key has them revoked as if the resource was replaced
+	if previousKeyId != keyId {
+		expiring, err := isExpiring(ctx, r.client, previousKeyId)
+		if err == nil && !expiring {
+			err = r.revoke(ctx, previousKeyId, state.Authoritative.ValueBool(), current)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Revoking Unkey Key Permissions",
+				"Could not remove permissions from Unkey Key ID "+previousKeyId+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	plan.Id = types.StringValue(keyId)
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *keyPermissionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state models.KeyPermissionsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.client.requestTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	keyId := state.KeyId.ValueString()
+
+	permissions, diags := conversions.StringSetToSlice(ctx, state.Permissions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.revoke(ctx, keyId, state.Authoritative.ValueBool(), permissions)
+	if err != nil {
+		// Nothing left to revoke
+		if isNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Revoking Unkey Key Permissions",
+			"Could not remove permissions from Unkey Key ID "+keyId+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// revoke removes permissions from a key. In authoritative mode the key is left
+// without any direct permissions.
+func (r *keyPermissionsResource) revoke(ctx context.Context, keyId string, authoritative bool, permissions []string) error {
+	var err error
+	if authoritative {
+		_, err = r.client.Keys.SetPermissions(ctx, components.V2KeysSetPermissionsRequestBody{
+			KeyID:       keyId,
+			Permissions: []string{},
+		})
+	} else if len(permissions) > 0 {
+		_, err = r.client.Keys.RemovePermissions(ctx, components.V2KeysRemovePermissionsRequestBody{
+			KeyID:       keyId,
+			Permissions: permissions,
+		})
+	}
+	return err
+}
+
+// ModifyPlan keeps id in step with key_id, which changes when the key is
+// rotated.
+func (r *keyPermissionsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var keyId types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("key_id"), &keyId)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), keyId)...)
+}
+
+// ImportState imports the direct permissions of a key by the key ID.
+// Imported permissions are tracked authoritatively until the first apply,
+// which switches to the mode of the configuration without revoking any
+// permission.
+func (r *keyPermissionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key_id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("authoritative"), true)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *keyPermissionsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unkeyClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.unkeyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
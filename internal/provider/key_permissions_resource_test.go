@@ -0,0 +1,280 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/fakeunkey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccKeyPermissionsResource(t *testing.T) {
+	server := fakeunkey.NewServer(t)
+
+	var keyId string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(server, "unkey_key"),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccKeyPermissionsResourceConfig(server, true, "documents.read"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("unkey_key_permissions.test", "id", "unkey_key.test", "id"),
+					resource.TestCheckResourceAttr("unkey_key_permissions.test", "permissions.#", "1"),
+					resource.TestCheckTypeSetElemAttr("unkey_key_permissions.test", "permissions.*", "documents.read"),
+					testAccCheckID("unkey_key.test", &keyId),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "unkey_key_permissions.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccKeyPermissionsResourceConfig(server, true, "documents.read", "documents.write"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("unkey_key_permissions.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unkey_key_permissions.test", "permissions.#", "2"),
+					resource.TestCheckTypeSetElemAttr("unkey_key_permissions.test", "permissions.*", "documents.write"),
+					testAccCheckKeyPermissions(server, &keyId, "documents.read", "documents.write"),
+				),
+			},
+			// Drift testing: permissions granted outside of Terraform are revoked
+			{
+				PreConfig: func() {
+					if err := server.SetKeyPermissions(keyId, "documents.read", "documents.write", "documents.delete"); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccKeyPermissionsResourceConfig(server, true, "documents.read", "documents.write"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("unkey_key_permissions.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: testAccCheckKeyPermissions(server, &keyId, "documents.read", "documents.write"),
+			},
+			// Delete testing: the key is left without permissions
+			{
+				Config: testAccKeyPermissionsResourceConfig(server, true),
+				Check:  testAccCheckKeyPermissions(server, &keyId),
+			},
+		},
+	})
+}
+
+func TestAccKeyPermissionsResource_additive(t *testing.T) {
+	server := fakeunkey.NewServer(t)
+
+	var keyId string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(server, "unkey_key"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyPermissionsResourceConfig(server, false),
+				Check:  testAccCheckID("unkey_key.test", &keyId),
+			},
+			// Create testing: a permission granted outside of the resource is kept
+			{
+				PreConfig: func() {
+					if err := server.SetKeyPermissions(keyId, "documents.admin"); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccKeyPermissionsResourceConfig(server, false, "documents.read"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unkey_key_permissions.test", "permissions.#", "1"),
+					resource.TestCheckTypeSetElemAttr("unkey_key_permissions.test", "permissions.*", "documents.read"),
+					testAccCheckKeyPermissions(server, &keyId, "documents.admin", "documents.read"),
+				),
+			},
+			// Update testing: only permissions the resource granted are revoked
+			{
+				Config: testAccKeyPermissionsResourceConfig(server, false, "documents.read", "documents.write"),
+				Check:  testAccCheckKeyPermissions(server, &keyId, "documents.admin", "documents.read", "documents.write"),
+			},
+			{
+				Config: testAccKeyPermissionsResourceConfig(server, false, "documents.write"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unkey_key_permissions.test", "permissions.#", "1"),
+					testAccCheckKeyPermissions(server, &keyId, "documents.admin", "documents.write"),
+				),
+			},
+			// Delete testing: the permission granted outside of the resource is kept
+			{
+				Config: testAccKeyPermissionsResourceConfig(server, false),
+				Check:  testAccCheckKeyPermissions(server, &keyId, "documents.admin"),
+			},
+			// ImportState testing: every direct permission of the key is imported
+			{
+				PreConfig: func() {
+					if err := server.SetKeyPermissions(keyId, "documents.admin", "documents.write"); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:       testAccKeyPermissionsResourceConfig(server, false, "documents.write"),
+				ResourceName: "unkey_key_permissions.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(*terraform.State) (string, error) {
+					return keyId, nil
+				},
+				ImportStatePersist: true,
+			},
+			// Switching the imported permissions to additive mode revokes nothing
+			{
+				Config: testAccKeyPermissionsResourceConfig(server, false, "documents.write"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("unkey_key_permissions.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unkey_key_permissions.test", "authoritative", "false"),
+					resource.TestCheckResourceAttr("unkey_key_permissions.test", "permissions.#", "1"),
+					testAccCheckKeyPermissions(server, &keyId, "documents.admin", "documents.write"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeyPermissionsResource_rotation(t *testing.T) {
+	server := fakeunkey.NewServer(t)
+
+	var keyId, rotatedKeyId, otherKeyId string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(server, "unkey_key"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyPermissionsResourceRotationConfig(server, "1", "test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckID("unkey_key.test", &keyId),
+					testAccCheckID("unkey_key.other", &otherKeyId),
+					testAccCheckKeyPermissions(server, &keyId, "documents.read"),
+				),
+			},
+			// Rotating the key moves the permissions to the new key in place,
+			// and the previous key keeps them for the overlap
+			{
+				Config: testAccKeyPermissionsResourceRotationConfig(server, "2", "test"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("unkey_key_permissions.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckID("unkey_key.test", &rotatedKeyId),
+					resource.TestCheckResourceAttrPair("unkey_key_permissions.test", "id", "unkey_key.test", "id"),
+					testAccCheckKeyPermissions(server, &rotatedKeyId, "documents.read"),
+					testAccCheckKeyPermissions(server, &keyId, "documents.read"),
+				),
+			},
+			// Moving the permissions to another key revokes them from the old one
+			{
+				Config: testAccKeyPermissionsResourceRotationConfig(server, "2", "other"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("unkey_key_permissions.test", "id", "unkey_key.other", "id"),
+					testAccCheckKeyPermissions(server, &otherKeyId, "documents.read"),
+					testAccCheckKeyPermissions(server, &rotatedKeyId),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckKeyPermissions verifies the permissions of a key in the fake
+// Unkey API.
+func testAccCheckKeyPermissions(server *fakeunkey.Server, keyId *string, want ...string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		got := server.KeyPermissions(*keyId)
+		slices.Sort(got)
+
+		if !slices.Equal(got, want) {
+			return fmt.Errorf("expected key %s to have permissions %v, got %v", *keyId, want, got)
+		}
+
+		return nil
+	}
+}
+
+// testAccKeyPermissionsResourceConfig grants the permissions to a key, or
+// omits the unkey_key_permissions resource when there are none.
+func testAccKeyPermissionsResourceConfig(server *fakeunkey.Server, authoritative bool, permissions ...string) string {
+	config := testAccProviderConfig(server) + `
+resource "unkey_api" "test" {
+  name = "payments"
+}
+
+resource "unkey_key" "test" {
+  api_id      = unkey_api.test.id
+  byte_length = 16
+}
+`
+
+	if len(permissions) == 0 {
+		return config
+	}
+
+	quoted := make([]string, len(permissions))
+	for i, permission := range permissions {
+		quoted[i] = fmt.Sprintf("%q", permission)
+	}
+
+	return config + fmt.Sprintf(`
+resource "unkey_key_permissions" "test" {
+  key_id        = unkey_key.test.id
+  permissions   = [%s]
+  authoritative = %t
+}
+`, strings.Join(quoted, ", "), authoritative)
+}
+
+// testAccKeyPermissionsResourceRotationConfig grants a permission to one of
+// two keys, the first of which is rotated whenever version changes.
+func testAccKeyPermissionsResourceRotationConfig(server *fakeunkey.Server, version, key string) string {
+	return testAccProviderConfig(server) + fmt.Sprintf(`
+resource "unkey_api" "test" {
+  name = "payments"
+}
+
+resource "unkey_key" "test" {
+  api_id      = unkey_api.test.id
+  byte_length = 16
+
+  rotation = {
+    rotate_when_changed = {
+      version = %q
+    }
+    overlap = 86400000
+  }
+}
+
+resource "unkey_key" "other" {
+  api_id      = unkey_api.test.id
+  byte_length = 16
+}
+
+resource "unkey_key_permissions" "test" {
+  key_id      = unkey_key.%s.id
+  permissions = ["documents.read"]
+}
+`, version, key)
+}
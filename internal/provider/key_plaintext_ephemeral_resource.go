@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/models"
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unkeyed/sdks/api/go/v2/models/components"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &keyPlaintextEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &keyPlaintextEphemeralResource{}
+)
+
+// NewKeyPlaintextEphemeralResource is a helper function to simplify the provider implementation.
+func NewKeyPlaintextEphemeralResource() ephemeral.EphemeralResource {
+	return &keyPlaintextEphemeralResource{}
+}
+
+// keyPlaintextEphemeralResource is the ephemeral resource implementation.
+type keyPlaintextEphemeralResource struct {
+	client *unkeyClient
+}
+
+// Metadata returns the ephemeral resource type name.
+func (e *keyPlaintextEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_key_plaintext"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (e *keyPlaintextEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schemas.KeyPlaintextEphemeralSchema()
+}
+
+// Open decrypts the key. The result is never persisted by Terraform.
+func (e *keyPlaintextEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	// Get lookup values from configuration
+	var result models.KeyPlaintextEphemeralModel
+	diags := req.Config.Get(ctx, &result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, e.client.requestTimeout)
+	defer cancel()
+
+	decrypt := true
+	key, err := e.client.Keys.GetKey(ctx, components.V2KeysGetKeyRequestBody{
+		KeyID:   result.KeyId.ValueString(),
+		Decrypt: &decrypt,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Decrypt Unkey Key",
+			"Could not decrypt Unkey Key ID "+result.KeyId.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	data := key.V2KeysGetKeyResponseBody.GetData()
+
+	// Unkey only stores the plaintext of recoverable keys
+	if data.Plaintext == nil {
+		resp.Diagnostics.AddError(
+			"Unkey Key Is Not Recoverable",
+			"Unkey Key ID "+result.KeyId.ValueString()+" was not created with recoverable = true, so its plaintext cannot be retrieved.",
+		)
+		return
+	}
+
+	result.KeyId = types.StringValue(data.KeyID)
+	result.Start = types.StringValue(data.Start)
+	result.Key = types.StringPointerValue(data.Plaintext)
+
+	// Set result
+	diags = resp.Result.Set(ctx, &result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the ephemeral resource.
+func (e *keyPlaintextEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unkeyClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *provider.unkeyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	e.client = client
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/fakeunkey"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccKeyPlaintextEphemeralResource(t *testing.T) {
+	server := fakeunkey.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		// Ephemeral resources are only available in 1.10 and later
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		Steps: []resource.TestStep{
+			// The plaintext of a recoverable key matches the key returned on creation
+			{
+				Config: testAccKeyPlaintextEphemeralResourceConfig(server, true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs(
+						"echo.test", tfjsonpath.New("data").AtMapKey("key"),
+						"unkey_key.test", tfjsonpath.New("key"),
+						compare.ValuesSame(),
+					),
+					statecheck.CompareValuePairs(
+						"echo.test", tfjsonpath.New("data").AtMapKey("id"),
+						"unkey_key.test", tfjsonpath.New("id"),
+						compare.ValuesSame(),
+					),
+				},
+			},
+			// Keys that are not recoverable cannot be decrypted
+			{
+				Config:      testAccKeyPlaintextEphemeralResourceConfig(server, false),
+				ExpectError: regexp.MustCompile("Unkey Key Is Not Recoverable"),
+			},
+		},
+	})
+}
+
+func testAccKeyPlaintextEphemeralResourceConfig(server *fakeunkey.Server, recoverable bool) string {
+	return testAccProviderConfig(server) + fmt.Sprintf(`
+resource "unkey_api" "test" {
+  name = "payments"
+}
+
+resource "unkey_key" "test" {
+  api_id      = unkey_api.test.id
+  byte_length = 16
+  recoverable = %t
+}
+
+ephemeral "unkey_key_plaintext" "test" {
+  id = unkey_key.test.id
+}
+
+provider "echo" {
+  data = ephemeral.unkey_key_plaintext.test
+}
+
+resource "echo" "test" {}
+`, recoverable)
+}
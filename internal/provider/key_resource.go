@@ -3,20 +3,28 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/conversions"
 	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/models"
 	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	unkey "github.com/unkeyed/sdks/api/go/v2"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/unkeyed/sdks/api/go/v2/models/components"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &keyResource{}
-	_ resource.ResourceWithConfigure = &keyResource{}
+	_ resource.Resource                   = &keyResource{}
+	_ resource.ResourceWithConfigure      = &keyResource{}
+	_ resource.ResourceWithImportState    = &keyResource{}
+	_ resource.ResourceWithModifyPlan     = &keyResource{}
+	_ resource.ResourceWithValidateConfig = &keyResource{}
 )
 
 // NewkeyResource is a helper function to simplify the provider implementation.
@@ -26,7 +34,7 @@ func NewKeyResource() resource.Resource {
 
 // keyResource is the resource implementation.
 type keyResource struct {
-	client *unkey.Unkey
+	client *unkeyClient
 }
 
 // Metadata returns the resource type name.
@@ -35,8 +43,8 @@ func (r *keyResource) Metadata(_ context.Context, req resource.MetadataRequest,
 }
 
 // Schema defines the schema for the resource.
-func (r *keyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schemas.KeySchema()
+func (r *keyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schemas.KeySchema(ctx)
 }
 
 // Create a new resource.
@@ -49,6 +57,15 @@ func (r *keyResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.client.requestTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	request := components.V2KeysCreateKeyRequestBody{
 		APIID:       plan.ApiId.ValueString(),
 		Prefix:      plan.Prefix.ValueStringPointer(),
@@ -79,19 +96,47 @@ func (r *keyResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
-	// Create new Key
-	key, err := r.client.Keys.CreateKey(ctx, request)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating API",
-			"Could not create API, unexpected error: "+err.Error(),
-		)
+	// plaintext is write-only, so it is only in the configuration
+	var plaintext types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("plaintext"), &plaintext)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Map response body to schema and populate Computed attribute values
-	plan.KeyId = types.StringValue(key.V2KeysCreateKeyResponseBody.Data.KeyID)
-	plan.Key = types.StringValue(key.V2KeysCreateKeyResponseBody.Data.Key)
+	if !plaintext.IsNull() {
+		// Migrate the existing key. Its owner already has it, so it is
+		// neither stored nor encrypted
+		keyId, err := migrateKey(ctx, r.client, plan.MigrationId.ValueString(), plaintext.ValueString(), request)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Migrating Unkey Key",
+				"Could not migrate the key into Unkey API ID "+plan.ApiId.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+
+		plan.KeyId = types.StringValue(keyId)
+		plan.Key = types.StringNull()
+		plan.EncryptedKey = types.StringNull()
+		plan.KeyFingerprint = types.StringNull()
+	} else {
+		// Create new Key
+		key, err := r.client.Keys.CreateKey(ctx, request)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating API",
+				"Could not create API, unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		// Map response body to schema and populate Computed attribute values
+		plan.KeyId = types.StringValue(key.V2KeysCreateKeyResponseBody.Data.KeyID)
+		setKey(&plan, key.V2KeysCreateKeyResponseBody.Data.Key, &resp.Diagnostics)
+	}
+
+	plan.PreviousKeyId = types.StringNull()
+	plan.RotatedAt = types.Int64Value(time.Now().UnixMilli())
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -111,11 +156,44 @@ func (r *keyResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
-	// Get refreshed API from Unkey
-	key, err := r.client.Keys.GetKey(ctx, components.V2KeysGetKeyRequestBody{
+	readTimeout, diags := state.Timeouts.Read(ctx, r.client.requestTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// rotated_at is set when a key is created, so only an imported key lacks it
+	imported := state.RotatedAt.IsNull()
+
+	// Get refreshed API from Unkey. An imported key is decrypted to find
+	// out whether it is recoverable, which Unkey does not report otherwise
+	request := components.V2KeysGetKeyRequestBody{
 		KeyID: state.KeyId.ValueString(),
-	})
+	}
+	if imported {
+		request.Decrypt = &imported
+	}
+
+	key, err := r.client.Keys.GetKey(ctx, request)
+
+	// A root key without the permission to decrypt keys can still import them
+	if imported && isForbidden(err) {
+		request.Decrypt = nil
+		key, err = r.client.Keys.GetKey(ctx, request)
+	}
 	if err != nil {
+		// The Key was deleted outside of Terraform, plan to recreate it
+		if isNotFound(err) {
+			tflog.Warn(ctx, "Unkey Key not found, removing from state", map[string]any{
+				"id": state.KeyId.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error Reading Unkey Key",
 			"Could not read Unkey Key ID "+state.KeyId.ValueString()+": "+err.Error(),
@@ -126,17 +204,31 @@ func (r *keyResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	data := key.V2KeysGetKeyResponseBody.GetData()
 
 	// Overwrite items with refreshed state
+	state.KeyId = types.StringValue(data.KeyID)
 	state.Name = types.StringPointerValue(data.Name)
 	state.Enabled = types.BoolValue(data.Enabled)
 	state.Expires = types.Int64PointerValue(data.Expires)
 
-	state.Permissions, diags = conversions.SliceToStringList(ctx, data.Permissions)
-	resp.Diagnostics.Append(diags...)
+	if data.Identity != nil {
+		state.ExternalId = types.StringValue(data.Identity.ExternalID)
+	} else {
+		state.ExternalId = types.StringNull()
+	}
 
-	state.Roles, diags = conversions.SliceToStringList(ctx, data.Roles)
-	resp.Diagnostics.Append(diags...)
+	// Roles and permissions that are not set inline may be managed by
+	// unkey_key_roles and unkey_key_permissions, so leave them untracked
+	// unless the key was just imported
+	if !state.Permissions.IsNull() || imported {
+		state.Permissions, diags = conversions.SliceToStringList(ctx, data.Permissions)
+		resp.Diagnostics.Append(diags...)
+	}
 
-	state.Meta, diags = conversions.MapToString(ctx, data.Meta)
+	if !state.Roles.IsNull() || imported {
+		state.Roles, diags = conversions.SliceToStringList(ctx, data.Roles)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	state.Meta, diags = conversions.MetaFromAPI(ctx, key.HTTPMeta.Response)
 	resp.Diagnostics.Append(diags...)
 
 	state.Credits, diags = conversions.CreditsFromAPI(ctx, data.Credits)
@@ -145,6 +237,38 @@ func (r *keyResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	state.Ratelimits, diags = conversions.RatelimitsFromAPI(ctx, data.Ratelimits)
 	resp.Diagnostics.Append(diags...)
 
+	// Imported keys count their age from when they were created, and take
+	// their prefix from the start of the key, which Unkey reports
+	if imported {
+		state.RotatedAt = types.Int64Value(data.CreatedAt)
+
+		if i := strings.LastIndex(data.Start, "_"); i > 0 && state.Prefix.IsNull() {
+			state.Prefix = types.StringValue(data.Start[:i])
+		}
+
+		if request.Decrypt != nil && state.Recoverable.IsNull() {
+			state.Recoverable = types.BoolValue(data.Plaintext != nil)
+		}
+	}
+
+	// Settings that are only used on creation or deletion are not reported
+	// by Unkey, and read as their defaults after an import
+	if state.ByteLength.IsNull() {
+		state.ByteLength = types.Int64Value(16)
+	}
+
+	if state.Recoverable.IsNull() {
+		state.Recoverable = types.BoolValue(false)
+	}
+
+	if state.PermanentDeletion.IsNull() {
+		state.PermanentDeletion = types.BoolValue(false)
+	}
+
+	if state.StoreKeyInState.IsNull() {
+		state.StoreKeyInState = types.BoolValue(true)
+	}
+
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -163,8 +287,54 @@ func (r *keyResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.client.requestTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	keyId := state.KeyId.ValueString()
 
+	// ModifyPlan leaves the ID unknown when the key is due for rotation
+	if plan.KeyId.IsUnknown() {
+		var rotation models.KeyRotationModel
+		resp.Diagnostics.Append(plan.Rotation.As(ctx, &rotation, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		rerolled, err := r.client.Keys.RerollKey(ctx, components.V2KeysRerollKeyRequestBody{
+			KeyID:      keyId,
+			Expiration: rotation.Overlap.ValueInt64(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error rotating key",
+				"Could not rotate key "+keyId+": "+err.Error(),
+			)
+			return
+		}
+
+		plan.PreviousKeyId = types.StringValue(keyId)
+		plan.KeyId = types.StringValue(rerolled.V2KeysRerollKeyResponseBody.Data.KeyID)
+		plan.RotatedAt = types.Int64Value(time.Now().UnixMilli())
+		setKey(&plan, rerolled.V2KeysRerollKeyResponseBody.Data.Key, &resp.Diagnostics)
+
+		// Keep track of the new key should the update below fail
+		state.PreviousKeyId = plan.PreviousKeyId
+		state.KeyId = plan.KeyId
+		state.RotatedAt = plan.RotatedAt
+		state.Key = plan.Key
+		state.EncryptedKey = plan.EncryptedKey
+		state.KeyFingerprint = plan.KeyFingerprint
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+		keyId = plan.KeyId.ValueString()
+	}
+
 	// Build update request - only include fields that can be updated
 	request := components.V2KeysUpdateKeyRequestBody{
 		KeyID:      keyId,
@@ -203,9 +373,12 @@ func (r *keyResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
-	// Read back the updated key to get the current state
+	// Read back the updated key to get the current state, decrypting it
+	// when store_key_in_state was enabled again
+	decrypt := plan.Key.IsUnknown() && keyInState(plan)
 	key, err := r.client.Keys.GetKey(ctx, components.V2KeysGetKeyRequestBody{
-		KeyID: keyId,
+		KeyID:   keyId,
+		Decrypt: &decrypt,
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -217,6 +390,18 @@ func (r *keyResource) Update(ctx context.Context, req resource.UpdateRequest, re
 
 	data := key.V2KeysGetKeyResponseBody.GetData()
 
+	if decrypt {
+		plan.Key = types.StringPointerValue(data.Plaintext)
+		if data.Plaintext == nil {
+			resp.Diagnostics.AddWarning(
+				"Unkey Key Not Stored In State",
+				"Key "+keyId+" is not recoverable, so it cannot be written to the state again.",
+			)
+		}
+	} else if plan.Key.IsUnknown() {
+		plan.Key = types.StringNull()
+	}
+
 	// Update state with API response
 	plan.Name = types.StringPointerValue(data.Name)
 	plan.Enabled = types.BoolValue(data.Enabled)
@@ -228,7 +413,7 @@ func (r *keyResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	state.Roles, diags = conversions.SliceToStringList(ctx, data.Roles)
 	resp.Diagnostics.Append(diags...)
 
-	state.Meta, diags = conversions.MapToString(ctx, data.Meta)
+	state.Meta, diags = conversions.MetaFromAPI(ctx, key.HTTPMeta.Response)
 	resp.Diagnostics.Append(diags...)
 
 	state.Credits, diags = conversions.CreditsFromAPI(ctx, data.Credits)
@@ -253,6 +438,15 @@ func (r *keyResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.client.requestTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	permanentDeletion := state.PermanentDeletion.ValueBool()
 
 	// Delete existing API
@@ -261,12 +455,231 @@ func (r *keyResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		Permanent: &permanentDeletion,
 	})
 	if err != nil {
+		// Nothing left to delete
+		if isNotFound(err) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error Deleting Unkey Key",
 			"Could not delete Key, unexpected error: "+err.Error(),
 		)
 		return
 	}
+
+	// Revoke the key this key was rotated from, unless its overlap has ended
+	if !state.PreviousKeyId.IsNull() {
+		_, err := r.client.Keys.DeleteKey(ctx, components.V2KeysDeleteKeyRequestBody{
+			KeyID:     state.PreviousKeyId.ValueString(),
+			Permanent: &permanentDeletion,
+		})
+		if err != nil && !isNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error Deleting Unkey Key",
+				"Could not delete previous Key "+state.PreviousKeyId.ValueString()+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+}
+
+// ImportState imports a key by its ID. Unkey does not report which API a key
+// belongs to, so the ID may also be given as <api_id>/<key_id> to populate
+// api_id as well. Settings that are only used on creation or deletion, such
+// as byte_length, cannot be read back and are read as their defaults.
+func (r *keyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	apiId, keyId, found := strings.Cut(req.ID, "/")
+	if !found {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	if apiId == "" || keyId == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <key_id> or <api_id>/<key_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("api_id"), apiId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), keyId)...)
+}
+
+// ModifyPlan plans a rotation when the key is due for one.
+func (r *keyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing is rotated on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan models.KeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rotate, diags := rotationDue(ctx, state, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if rotate {
+		plan.KeyId = types.StringUnknown()
+		plan.Key = types.StringUnknown()
+		plan.EncryptedKey = types.StringUnknown()
+		plan.PreviousKeyId = types.StringUnknown()
+		plan.RotatedAt = types.Int64Unknown()
+	}
+
+	// The key leaves the state when store_key_in_state is disabled, and is
+	// decrypted into it again when it is enabled again
+	switch {
+	case plan.StoreKeyInState.IsUnknown() || plan.PgpKey.IsUnknown() || plan.AgeRecipient.IsUnknown():
+		plan.Key = types.StringUnknown()
+	case !keyInState(plan):
+		plan.Key = types.StringNull()
+	case !keyInState(state) && state.Key.IsNull():
+		plan.Key = types.StringUnknown()
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// ValidateConfig ensures a key kept out of the state can still be retrieved.
+func (r *keyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config models.KeyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.PgpKey.IsNull() && !config.PgpKey.IsUnknown() {
+		if _, err := parsePGPRecipient(config.PgpKey.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("pgp_key"),
+				"Invalid PGP Public Key",
+				"The pgp_key attribute must be an ASCII-armored or base64-encoded PGP public key that can encrypt: "+err.Error(),
+			)
+		}
+	}
+
+	if !config.AgeRecipient.IsNull() && !config.AgeRecipient.IsUnknown() {
+		if _, err := parseAgeRecipient(config.AgeRecipient.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("age_recipient"),
+				"Invalid Age Recipient",
+				"The age_recipient attribute must be an age public key starting with age1: "+err.Error(),
+			)
+		}
+	}
+
+	if config.StoreKeyInState.IsNull() || config.StoreKeyInState.IsUnknown() || config.StoreKeyInState.ValueBool() {
+		return
+	}
+
+	// An encrypted key is delivered through encrypted_key instead, and a
+	// migrated key is already known to its owner
+	if config.Recoverable.IsUnknown() || config.Recoverable.ValueBool() || !config.PgpKey.IsNull() || !config.AgeRecipient.IsNull() || !config.Plaintext.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("store_key_in_state"),
+		"Key Would Be Lost",
+		"A key that is not stored in the state must be created with recoverable = true, so it can be retrieved with the unkey_key_plaintext ephemeral resource, "+
+			"or be encrypted with pgp_key or age_recipient.",
+	)
+}
+
+// keyInState reports whether the plaintext key is written to the state, which
+// it is not when it is encrypted or store_key_in_state is disabled.
+func keyInState(model models.KeyResourceModel) bool {
+	return model.StoreKeyInState.ValueBool() && model.PgpKey.IsNull() && model.AgeRecipient.IsNull()
+}
+
+// setKey records a newly generated key in the model, in plaintext when it is
+// stored in the state and encrypted when a recipient is configured.
+func setKey(model *models.KeyResourceModel, secret string, diags *diag.Diagnostics) {
+	model.Key = types.StringNull()
+	if keyInState(*model) {
+		model.Key = types.StringValue(secret)
+	}
+
+	model.EncryptedKey = types.StringNull()
+	model.KeyFingerprint = types.StringNull()
+
+	recipient, err := parseKeyRecipient(model.PgpKey, model.AgeRecipient)
+	if recipient == nil && err == nil {
+		return
+	}
+
+	var encrypted string
+	if err == nil {
+		encrypted, err = recipient.Encrypt(secret)
+	}
+	if err != nil {
+		diags.AddError(
+			"Error Encrypting Unkey Key",
+			"Could not encrypt the key for pgp_key or age_recipient: "+err.Error(),
+		)
+		return
+	}
+
+	model.EncryptedKey = types.StringValue(encrypted)
+	model.KeyFingerprint = types.StringValue(recipient.Fingerprint())
+}
+
+// rotationDue reports whether a key must be rotated, because its
+// rotate_when_changed values changed or it is older than rotation_days.
+func rotationDue(ctx context.Context, state, plan models.KeyResourceModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if plan.Rotation.IsNull() || plan.Rotation.IsUnknown() {
+		return false, diags
+	}
+
+	var rotation models.KeyRotationModel
+	diags.Append(plan.Rotation.As(ctx, &rotation, basetypes.ObjectAsOptions{})...)
+
+	// Adding or removing the triggers does not rotate the key
+	if !state.Rotation.IsNull() {
+		var previous models.KeyRotationModel
+		diags.Append(state.Rotation.As(ctx, &previous, basetypes.ObjectAsOptions{})...)
+
+		if !rotation.RotateWhenChanged.IsNull() && !previous.RotateWhenChanged.IsNull() &&
+			!rotation.RotateWhenChanged.Equal(previous.RotateWhenChanged) {
+			return true, diags
+		}
+	}
+
+	if rotation.RotationDays.IsNull() || rotation.RotationDays.IsUnknown() || state.RotatedAt.IsNull() {
+		return false, diags
+	}
+
+	age := time.Since(time.UnixMilli(state.RotatedAt.ValueInt64()))
+
+	return age >= time.Duration(rotation.RotationDays.ValueInt64())*24*time.Hour, diags
+}
+
+// isExpiring reports whether a key goes away on its own: a rotated key expires
+// when its overlap ends, or is already deleted when there is none. Its roles
+// and permissions are then left alone, so clients still using it keep their
+// access during the overlap.
+func isExpiring(ctx context.Context, client *unkeyClient, keyId string) (bool, error) {
+	key, err := client.Keys.GetKey(ctx, components.V2KeysGetKeyRequestBody{
+		KeyID: keyId,
+	})
+	if isNotFound(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return key.V2KeysGetKeyResponseBody.GetData().Expires != nil, nil
 }
 
 // Configure adds the provider configured client to the resource.
@@ -277,12 +690,12 @@ func (r *keyResource) Configure(_ context.Context, req resource.ConfigureRequest
 		return
 	}
 
-	client, ok := req.ProviderData.(*unkey.Unkey)
+	client, ok := req.ProviderData.(*unkeyClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *unkey.Unkey, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.unkeyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
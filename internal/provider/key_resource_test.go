@@ -0,0 +1,569 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"testing"
+
+	"filippo.io/age"
+	"filippo.io/age/armor"
+	"github.com/ProtonMail/go-crypto/openpgp"
+	pgparmor "github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/fakeunkey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/unkeyed/sdks/api/go/v2/models/components"
+)
+
+func TestAccKeyResource(t *testing.T) {
+	server := fakeunkey.NewServer(t)
+
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(server, "unkey_key"),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccKeyResourceConfig(server, "first", true, 100),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("unkey_key.test", "api_id", "unkey_api.test", "id"),
+					resource.TestCheckResourceAttr("unkey_key.test", "name", "first"),
+					resource.TestCheckResourceAttr("unkey_key.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("unkey_key.test", "external_id", "user_123"),
+					resource.TestCheckResourceAttr("unkey_key.test", "meta", `{"plan":"free"}`),
+					resource.TestCheckResourceAttr("unkey_key.test", "credits.remaining", "100"),
+					resource.TestCheckResourceAttr("unkey_key.test", "credits.refill.interval", "daily"),
+					resource.TestCheckResourceAttr("unkey_key.test", "ratelimits.#", "1"),
+					resource.TestCheckResourceAttrSet("unkey_key.test", "key"),
+					resource.TestCheckResourceAttrSet("unkey_key.test", "id"),
+					testAccCheckID("unkey_key.test", &id),
+				),
+			},
+			// ImportState testing with the API ID, as Unkey does not report
+			// which API a key belongs to. The key itself is only returned on
+			// creation.
+			{
+				ResourceName:            "unkey_key.test",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccKeyImportID("unkey_key.test"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"key"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccKeyResourceConfig(server, "second", false, 50),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("unkey_key.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unkey_key.test", "name", "second"),
+					resource.TestCheckResourceAttr("unkey_key.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("unkey_key.test", "credits.remaining", "50"),
+					resource.TestCheckResourceAttrPtr("unkey_key.test", "id", &id),
+				),
+			},
+			// Drift testing: a key renamed outside of Terraform is renamed back
+			{
+				PreConfig: func() {
+					err := server.UpdateKey(id, func(key *components.KeyResponseData) {
+						name := "renamed"
+						key.Name = &name
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccKeyResourceConfig(server, "second", false, 50),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("unkey_key.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("unkey_key.test", "name", "second"),
+			},
+			// Drift testing: meta changed outside of Terraform is read back
+			// without rounding integers above 2^53
+			{
+				PreConfig: func() {
+					err := server.UpdateKey(id, func(key *components.KeyResponseData) {
+						key.Meta = map[string]any{"account": json.Number("12345678901234567890")}
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+				Check:              resource.TestCheckResourceAttr("unkey_key.test", "meta", `{"account":12345678901234567890}`),
+			},
+			// Drift testing: the key is recreated after being deleted outside of Terraform
+			{
+				PreConfig: testAccDelete(t, server, &id),
+				Config:    testAccKeyResourceConfig(server, "second", false, 50),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("unkey_key.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccKeyResource_import(t *testing.T) {
+	server := fakeunkey.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_5_0),
+		},
+		CheckDestroy: testAccCheckDestroyed(server, "unkey_key"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyResourceImportConfig(server),
+			},
+			// The first plan after an import is empty, with the settings
+			// Unkey does not report read as their defaults, the prefix taken
+			// from the start of the key and the roles and permissions read
+			{
+				Config:            testAccKeyResourceImportConfig(server),
+				ResourceName:      "unkey_key.test",
+				ImportState:       true,
+				ImportStateKind:   resource.ImportBlockWithID,
+				ImportStateIdFunc: testAccKeyImportID("unkey_key.test"),
+			},
+		},
+	})
+}
+
+func TestAccKeyResource_rotation(t *testing.T) {
+	server := fakeunkey.NewServer(t)
+
+	var id, rotatedId string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(server, "unkey_key"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyResourceRotationConfig(server, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("unkey_key.test", "previous_key_id"),
+					resource.TestCheckResourceAttrSet("unkey_key.test", "rotated_at"),
+					testAccCheckID("unkey_key.test", &id),
+				),
+			},
+			// Changing rotate_when_changed rerolls the key in place and keeps
+			// the previous key valid for the overlap
+			{
+				Config: testAccKeyResourceRotationConfig(server, "2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("unkey_key.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue("unkey_key.test", tfjsonpath.New("key")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("unkey_key.test", "previous_key_id", &id),
+					resource.TestCheckResourceAttr("unkey_key.test", "name", "rotated"),
+					resource.TestMatchResourceAttr("unkey_key.test", "key", regexp.MustCompile("^pay_")),
+					testAccCheckID("unkey_key.test", &rotatedId),
+					func(_ *terraform.State) error {
+						if rotatedId == id {
+							return fmt.Errorf("expected key %s to be rotated", id)
+						}
+						if !server.Exists(id) {
+							return fmt.Errorf("expected previous key %s to be valid during the overlap", id)
+						}
+						return nil
+					},
+				),
+			},
+			// Keys are not rotated again until the triggers change
+			{
+				Config: testAccKeyResourceRotationConfig(server, "2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Delete testing: the previous key is revoked with the key
+			{
+				Config: testAccProviderConfig(server) + `
+resource "unkey_api" "test" {
+  name = "payments"
+}
+`,
+				Check: func(_ *terraform.State) error {
+					if server.Exists(id) {
+						return fmt.Errorf("previous key %s still exists", id)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccKeyResource_storeKeyInState(t *testing.T) {
+	server := fakeunkey.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(server, "unkey_key"),
+		Steps: []resource.TestStep{
+			// Keys that cannot be recovered must be stored in the state
+			{
+				Config:      testAccKeyResourceStoreConfig(server, false, false),
+				ExpectError: regexp.MustCompile("Key Would Be Lost"),
+			},
+			{
+				Config: testAccKeyResourceStoreConfig(server, false, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("unkey_key.test", "key"),
+					resource.TestCheckResourceAttrSet("unkey_key.test", "id"),
+				),
+			},
+			// Storing the key again decrypts it into the state
+			{
+				Config: testAccKeyResourceStoreConfig(server, true, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("unkey_key.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue("unkey_key.test", tfjsonpath.New("key")),
+					},
+				},
+				Check: resource.TestCheckResourceAttrSet("unkey_key.test", "key"),
+			},
+			{
+				Config: testAccKeyResourceStoreConfig(server, false, true),
+				Check:  resource.TestCheckNoResourceAttr("unkey_key.test", "key"),
+			},
+		},
+	})
+}
+
+func TestAccKeyResource_migrate(t *testing.T) {
+	server := fakeunkey.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckDestroyed(server, "unkey_key"),
+		Steps: []resource.TestStep{
+			// Keys migrated through plaintext are stored by their hash only
+			{
+				Config: testAccKeyResourceMigrateConfig(server, "sk_live_existing", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("unkey_key.test", "id"),
+					resource.TestCheckNoResourceAttr("unkey_key.test", "key"),
+					resource.TestCheckNoResourceAttr("unkey_key.test", "plaintext"),
+					resource.TestCheckResourceAttr("unkey_key.test", "name", "migrated"),
+					testAccCheckKeyHash(server, "unkey_key.test", "sk_live_existing"),
+				),
+			},
+			// A new version of the key replaces the migrated one
+			{
+				Config: testAccKeyResourceMigrateConfig(server, "sk_live_replacement", 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("unkey_key.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: testAccCheckKeyHash(server, "unkey_key.test", "sk_live_replacement"),
+			},
+		},
+	})
+}
+
+func TestAccKeyResource_encrypted(t *testing.T) {
+	server := fakeunkey.NewServer(t)
+
+	identity, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entity, err := openpgp.NewEntity("Billing", "", "billing@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	publicKey := testPGPPublicKey(t, entity)
+
+	signing, err := openpgp.NewEntity("Signing", "", "signing@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signing.Subkeys = nil
+	signingOnly := testPGPPublicKey(t, signing)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(server, "unkey_key"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyResourceEncryptedConfig(server, "age_recipient", identity.Recipient().String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("unkey_key.test", "key"),
+					resource.TestCheckResourceAttr("unkey_key.test", "key_fingerprint", identity.Recipient().String()),
+					testAccCheckEncryptedKey("unkey_key.test", func(ciphertext string) (io.Reader, error) {
+						return age.Decrypt(armor.NewReader(strings.NewReader(ciphertext)), identity)
+					}),
+				),
+			},
+			// Switching recipients creates a new key, as the plaintext of the
+			// existing one is not available to encrypt it again
+			{
+				Config: testAccKeyResourceEncryptedConfig(server, "pgp_key", publicKey),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("unkey_key.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("unkey_key.test", "key"),
+					resource.TestCheckResourceAttr("unkey_key.test", "key_fingerprint", strings.ToUpper(hex.EncodeToString(entity.PrimaryKey.Fingerprint))),
+					testAccCheckEncryptedKey("unkey_key.test", func(ciphertext string) (io.Reader, error) {
+						block, err := pgparmor.Decode(strings.NewReader(ciphertext))
+						if err != nil {
+							return nil, err
+						}
+
+						message, err := openpgp.ReadMessage(block.Body, openpgp.EntityList{entity}, nil, nil)
+						if err != nil {
+							return nil, err
+						}
+
+						return message.UnverifiedBody, nil
+					}),
+				),
+			},
+			{
+				Config:      testAccKeyResourceEncryptedConfig(server, "age_recipient", "age1invalid"),
+				ExpectError: regexp.MustCompile("Invalid Age Recipient"),
+			},
+			// A key that cannot encrypt fails before a key is created
+			{
+				Config:      testAccKeyResourceEncryptedConfig(server, "pgp_key", signingOnly),
+				ExpectError: regexp.MustCompile("no valid encryption subkey"),
+			},
+		},
+	})
+}
+
+// testPGPPublicKey returns the ASCII-armored public key of a PGP entity.
+func testPGPPublicKey(t *testing.T, entity *openpgp.Entity) string {
+	var publicKey strings.Builder
+
+	armored, err := pgparmor.Encode(&publicKey, openpgp.PublicKeyType, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := entity.Serialize(armored); err != nil {
+		t.Fatal(err)
+	}
+	if err := armored.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return publicKey.String()
+}
+
+// testAccCheckEncryptedKey verifies that encrypted_key decrypts to a key.
+func testAccCheckEncryptedKey(name string, decrypt func(ciphertext string) (io.Reader, error)) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", name)
+		}
+
+		plaintext, err := decrypt(rs.Primary.Attributes["encrypted_key"])
+		if err != nil {
+			return fmt.Errorf("decrypting encrypted_key: %w", err)
+		}
+
+		key, err := io.ReadAll(plaintext)
+		if err != nil {
+			return fmt.Errorf("decrypting encrypted_key: %w", err)
+		}
+
+		if !regexp.MustCompile("^pay_[0-9a-f]{32}$").Match(key) {
+			return fmt.Errorf("expected encrypted_key to hold a key, got %q", key)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckKeyHash verifies that Unkey stores the hash of the plaintext
+// for a key.
+func testAccCheckKeyHash(server *fakeunkey.Server, name, plaintext string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", name)
+		}
+
+		if got, want := server.KeyHash(rs.Primary.ID), hashKey(plaintext); got != want {
+			return fmt.Errorf("expected Unkey to store the hash %s, got %q", want, got)
+		}
+
+		return nil
+	}
+}
+
+// testAccKeyImportID returns the <api_id>/<key_id> import ID of a key.
+func testAccKeyImportID(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found in state", name)
+		}
+
+		return rs.Primary.Attributes["api_id"] + "/" + rs.Primary.ID, nil
+	}
+}
+
+func testAccKeyResourceConfig(server *fakeunkey.Server, name string, enabled bool, credits int) string {
+	return testAccProviderConfig(server) + fmt.Sprintf(`
+resource "unkey_api" "test" {
+  name = "payments"
+}
+
+resource "unkey_key" "test" {
+  api_id      = unkey_api.test.id
+  byte_length = 16
+  name        = %q
+  enabled     = %t
+  external_id = "user_123"
+  meta        = jsonencode({ plan = "free" })
+
+  credits = {
+    remaining = %d
+    refill = {
+      interval = "daily"
+      amount   = 100
+    }
+  }
+
+  ratelimits = [
+    {
+      name       = "requests"
+      limit      = 10
+      duration   = 60000
+      auto_apply = true
+    },
+  ]
+}
+`, name, enabled, credits)
+}
+
+func testAccKeyResourceImportConfig(server *fakeunkey.Server) string {
+	return testAccProviderConfig(server) + `
+resource "unkey_api" "test" {
+  name = "payments"
+}
+
+resource "unkey_role" "reader" {
+  name = "reader"
+}
+
+resource "unkey_key" "test" {
+  api_id      = unkey_api.test.id
+  prefix      = "pay_live"
+  name        = "imported"
+  recoverable = true
+  roles       = [unkey_role.reader.name]
+  permissions = ["documents.read"]
+}
+`
+}
+
+func testAccKeyResourceRotationConfig(server *fakeunkey.Server, version string) string {
+	return testAccProviderConfig(server) + fmt.Sprintf(`
+resource "unkey_api" "test" {
+  name = "payments"
+}
+
+resource "unkey_key" "test" {
+  api_id      = unkey_api.test.id
+  byte_length = 16
+  prefix      = "pay"
+  name        = "rotated"
+
+  rotation = {
+    rotate_when_changed = {
+      version = %q
+    }
+    rotation_days = 90
+    overlap       = 86400000
+  }
+}
+`, version)
+}
+
+func testAccKeyResourceStoreConfig(server *fakeunkey.Server, storeKeyInState, recoverable bool) string {
+	return testAccProviderConfig(server) + fmt.Sprintf(`
+resource "unkey_api" "test" {
+  name = "payments"
+}
+
+resource "unkey_key" "test" {
+  api_id             = unkey_api.test.id
+  byte_length        = 16
+  recoverable        = %t
+  store_key_in_state = %t
+}
+`, recoverable, storeKeyInState)
+}
+
+func testAccKeyResourceEncryptedConfig(server *fakeunkey.Server, attribute, publicKey string) string {
+	return testAccProviderConfig(server) + fmt.Sprintf(`
+resource "unkey_api" "test" {
+  name = "payments"
+}
+
+resource "unkey_key" "test" {
+  api_id      = unkey_api.test.id
+  byte_length = 16
+  prefix      = "pay"
+  %s = %q
+}
+`, attribute, publicKey)
+}
+
+func testAccKeyResourceMigrateConfig(server *fakeunkey.Server, plaintext string, version int) string {
+	return testAccProviderConfig(server) + fmt.Sprintf(`
+resource "unkey_api" "test" {
+  name = "payments"
+}
+
+resource "unkey_key" "test" {
+  api_id            = unkey_api.test.id
+  prefix            = "sk_live"
+  name              = "migrated"
+  plaintext         = %q
+  plaintext_version = %d
+  migration_id      = "terraform"
+}
+`, plaintext, version)
+}
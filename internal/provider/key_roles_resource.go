@@ -0,0 +1,376 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/conversions"
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/models"
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/unkeyed/sdks/api/go/v2/models/components"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &keyRolesResource{}
+	_ resource.ResourceWithConfigure   = &keyRolesResource{}
+	_ resource.ResourceWithImportState = &keyRolesResource{}
+	_ resource.ResourceWithModifyPlan  = &keyRolesResource{}
+)
+
+// NewKeyRolesResource is a helper function to simplify the provider implementation.
+func NewKeyRolesResource() resource.Resource {
+	return &keyRolesResource{}
+}
+
+// keyRolesResource is the resource implementation.
+type keyRolesResource struct {
+	client *unkeyClient
+}
+
+// Metadata returns the resource type name.
+func (r *keyRolesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_key_roles"
+}
+
+// Schema defines the schema for the resource.
+func (r *keyRolesResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schemas.KeyRolesSchema(ctx)
+}
+
+// Create a new resource.
+func (r *keyRolesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan models.KeyRolesResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.client.requestTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	keyId := plan.KeyId.ValueString()
+
+	roles, diags := conversions.StringSetToSlice(ctx, plan.Roles)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var err error
+	if plan.Authoritative.ValueBool() {
+		_, err = r.client.Keys.SetRoles(ctx, components.V2KeysSetRolesRequestBody{
+			KeyID: keyId,
+			Roles: roles,
+		})
+	} else if len(roles) > 0 {
+		_, err = r.client.Keys.AddRoles(ctx, components.V2KeysAddRolesRequestBody{
+			KeyID: keyId,
+			Roles: roles,
+		})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Assigning Unkey Key Roles",
+			"Could not assign roles to Unkey Key ID "+keyId+": "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.Id = types.StringValue(keyId)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *keyRolesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state models.KeyRolesResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, r.client.requestTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed key from Unkey
+	key, err := r.client.Keys.GetKey(ctx, components.V2KeysGetKeyRequestBody{
+		KeyID: state.KeyId.ValueString(),
+	})
+	if err != nil {
+		// The key was deleted outside of Terraform, and its roles with it
+		if isNotFound(err) {
+			tflog.Warn(ctx, "Unkey Key not found, removing key roles from state", map[string]any{
+				"key_id": state.KeyId.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Unkey Key Roles",
+			"Could not read roles of Unkey Key ID "+state.KeyId.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	roles := key.V2KeysGetKeyResponseBody.GetData().Roles
+
+	// In additive mode only the roles declared by this resource are tracked
+	if !state.Authoritative.ValueBool() {
+		managed, diags := conversions.StringSetToSlice(ctx, state.Roles)
+		resp.Diagnostics.Append(diags...)
+		roles = intersection(managed, roles)
+	}
+
+	// Overwrite items with refreshed state
+	state.Id = types.StringValue(state.KeyId.ValueString())
+
+	state.Roles, diags = conversions.SliceToStringSet(ctx, roles)
+	resp.Diagnostics.Append(diags...)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *keyRolesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get current state and plan
+	var state, plan models.KeyRolesResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.client.requestTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	keyId := plan.KeyId.ValueString()
+
+	planned, diags := conversions.StringSetToSlice(ctx, plan.Roles)
+	resp.Diagnostics.Append(diags...)
+
+	current, diags := conversions.StringSetToSlice(ctx, state.Roles)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A new key, such as one rotated by unkey_key, needs every planned role
+	previousKeyId := state.KeyId.ValueString()
+	assigned := current
+	if previousKeyId != keyId {
+		assigned = nil
+	}
+
+	if plan.Authoritative.ValueBool() {
+		_, err := r.client.Keys.SetRoles(ctx, components.V2KeysSetRolesRequestBody{
+			KeyID: keyId,
+			Roles: planned,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Assigning Unkey Key Roles",
+				"Could not set roles of Unkey Key ID "+keyId+": "+err.Error(),
+			)
+			return
+		}
+	} else {
+		if added := difference(planned, assigned); len(added) > 0 {
+			_, err := r.client.Keys.AddRoles(ctx, components.V2KeysAddRolesRequestBody{
+				KeyID: keyId,
+				Roles: added,
+			})
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error Assigning Unkey Key Roles",
+					"Could not add roles to Unkey Key ID "+keyId+": "+err.Error(),
+				)
+				return
+			}
+		}
+
+		// Roles tracked in authoritative mode may have been granted by
+		// someone else, so only revoke roles this resource added itself
+		if removed := difference(assigned, planned); len(removed) > 0 && !state.Authoritative.ValueBool() {
+			_, err := r.client.Keys.RemoveRoles(ctx, components.V2KeysRemoveRolesRequestBody{
+				KeyID: keyId,
+				Roles: removed,
+			})
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error Revoking Unkey Key Roles",
+					"Could not remove roles from Unkey Key ID "+keyId+": "+err.Error(),
+				)
+				return
+			}
+		}
+	}
+
+	// A rotated key keeps its roles until its overlap ends, any other
+	// previous key has them revoked as if the resource was replaced
+	if previousKeyId != keyId {
+		expiring, err := isExpiring(ctx, r.client, previousKeyId)
+		if err == nil && !expiring {
+			err = r.revoke(ctx, previousKeyId, state.Authoritative.ValueBool(), current)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Revoking Unkey Key Roles",
+				"Could not remove roles from Unkey Key ID "+previousKeyId+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	plan.Id = types.StringValue(keyId)
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *keyRolesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state models.KeyRolesResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.client.requestTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	keyId := state.KeyId.ValueString()
+
+	roles, diags := conversions.StringSetToSlice(ctx, state.Roles)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.revoke(ctx, keyId, state.Authoritative.ValueBool(), roles)
+	if err != nil {
+		// Nothing left to revoke
+		if isNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Revoking Unkey Key Roles",
+			"Could not remove roles from Unkey Key ID "+keyId+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// revoke removes roles from a key. In authoritative mode the key is left
+// without any roles.
+func (r *keyRolesResource) revoke(ctx context.Context, keyId string, authoritative bool, roles []string) error {
+	var err error
+	if authoritative {
+		_, err = r.client.Keys.SetRoles(ctx, components.V2KeysSetRolesRequestBody{
+			KeyID: keyId,
+			Roles: []string{},
+		})
+	} else if len(roles) > 0 {
+		_, err = r.client.Keys.RemoveRoles(ctx, components.V2KeysRemoveRolesRequestBody{
+			KeyID: keyId,
+			Roles: roles,
+		})
+	}
+	return err
+}
+
+// ModifyPlan keeps id in step with key_id, which changes when the key is
+// rotated.
+func (r *keyRolesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var keyId types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("key_id"), &keyId)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), keyId)...)
+}
+
+// ImportState imports the roles of a key by the key ID. Imported roles are
+// tracked authoritatively until the first apply, which switches to the mode
+// of the configuration without revoking any role.
+func (r *keyRolesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key_id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("authoritative"), true)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *keyRolesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unkeyClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.unkeyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
@@ -0,0 +1,296 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/fakeunkey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccKeyRolesResource(t *testing.T) {
+	server := fakeunkey.NewServer(t)
+
+	var keyId string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(server, "unkey_key"),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccKeyRolesResourceConfig(server, true, "unkey_role.reader.name"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("unkey_key_roles.test", "id", "unkey_key.test", "id"),
+					resource.TestCheckResourceAttr("unkey_key_roles.test", "roles.#", "1"),
+					resource.TestCheckTypeSetElemAttr("unkey_key_roles.test", "roles.*", "reader"),
+					testAccCheckID("unkey_key.test", &keyId),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "unkey_key_roles.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccKeyRolesResourceConfig(server, true, "unkey_role.reader.name", "unkey_role.writer.name"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("unkey_key_roles.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unkey_key_roles.test", "roles.#", "2"),
+					resource.TestCheckTypeSetElemAttr("unkey_key_roles.test", "roles.*", "writer"),
+					testAccCheckKeyRoles(server, &keyId, "reader", "writer"),
+				),
+			},
+			// Drift testing: roles revoked outside of Terraform are assigned again
+			{
+				PreConfig: func() {
+					if err := server.SetKeyRoles(keyId, "reader"); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccKeyRolesResourceConfig(server, true, "unkey_role.reader.name", "unkey_role.writer.name"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("unkey_key_roles.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: testAccCheckKeyRoles(server, &keyId, "reader", "writer"),
+			},
+			// Delete testing: the key is left without roles
+			{
+				Config: testAccKeyRolesResourceConfig(server, true),
+				Check:  testAccCheckKeyRoles(server, &keyId),
+			},
+		},
+	})
+}
+
+func TestAccKeyRolesResource_additive(t *testing.T) {
+	server := fakeunkey.NewServer(t)
+
+	var keyId string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(server, "unkey_key"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyRolesResourceConfig(server, false),
+				Check:  testAccCheckID("unkey_key.test", &keyId),
+			},
+			// Create testing: a role granted outside of the resource is kept
+			{
+				PreConfig: func() {
+					if err := server.SetKeyRoles(keyId, "admin"); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccKeyRolesResourceConfig(server, false, "unkey_role.reader.name"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unkey_key_roles.test", "roles.#", "1"),
+					resource.TestCheckTypeSetElemAttr("unkey_key_roles.test", "roles.*", "reader"),
+					testAccCheckKeyRoles(server, &keyId, "admin", "reader"),
+				),
+			},
+			// Update testing: only roles the resource assigned are revoked
+			{
+				Config: testAccKeyRolesResourceConfig(server, false, "unkey_role.reader.name", "unkey_role.writer.name"),
+				Check:  testAccCheckKeyRoles(server, &keyId, "admin", "reader", "writer"),
+			},
+			{
+				Config: testAccKeyRolesResourceConfig(server, false, "unkey_role.writer.name"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unkey_key_roles.test", "roles.#", "1"),
+					testAccCheckKeyRoles(server, &keyId, "admin", "writer"),
+				),
+			},
+			// Delete testing: the role granted outside of the resource is kept
+			{
+				Config: testAccKeyRolesResourceConfig(server, false),
+				Check:  testAccCheckKeyRoles(server, &keyId, "admin"),
+			},
+			// ImportState testing: every role of the key is imported
+			{
+				PreConfig: func() {
+					if err := server.SetKeyRoles(keyId, "admin", "writer"); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:       testAccKeyRolesResourceConfig(server, false, "unkey_role.writer.name"),
+				ResourceName: "unkey_key_roles.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(*terraform.State) (string, error) {
+					return keyId, nil
+				},
+				ImportStatePersist: true,
+			},
+			// Switching the imported roles to additive mode revokes nothing
+			{
+				Config: testAccKeyRolesResourceConfig(server, false, "unkey_role.writer.name"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("unkey_key_roles.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unkey_key_roles.test", "authoritative", "false"),
+					resource.TestCheckResourceAttr("unkey_key_roles.test", "roles.#", "1"),
+					testAccCheckKeyRoles(server, &keyId, "admin", "writer"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeyRolesResource_rotation(t *testing.T) {
+	server := fakeunkey.NewServer(t)
+
+	var keyId, rotatedKeyId, otherKeyId string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(server, "unkey_key"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyRolesResourceRotationConfig(server, "1", "test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckID("unkey_key.test", &keyId),
+					testAccCheckID("unkey_key.other", &otherKeyId),
+					testAccCheckKeyRoles(server, &keyId, "reader"),
+				),
+			},
+			// Rotating the key moves the roles to the new key in place, and
+			// the previous key keeps them for the overlap
+			{
+				Config: testAccKeyRolesResourceRotationConfig(server, "2", "test"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("unkey_key_roles.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckID("unkey_key.test", &rotatedKeyId),
+					resource.TestCheckResourceAttrPair("unkey_key_roles.test", "id", "unkey_key.test", "id"),
+					resource.TestCheckResourceAttrPair("unkey_key_roles.test", "key_id", "unkey_key.test", "id"),
+					testAccCheckKeyRoles(server, &rotatedKeyId, "reader"),
+					testAccCheckKeyRoles(server, &keyId, "reader"),
+				),
+			},
+			// Moving the roles to another key revokes them from the old one
+			{
+				Config: testAccKeyRolesResourceRotationConfig(server, "2", "other"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("unkey_key_roles.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("unkey_key_roles.test", "id", "unkey_key.other", "id"),
+					testAccCheckKeyRoles(server, &otherKeyId, "reader"),
+					testAccCheckKeyRoles(server, &rotatedKeyId),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckKeyRoles verifies the roles of a key in the fake Unkey API.
+func testAccCheckKeyRoles(server *fakeunkey.Server, keyId *string, want ...string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		got := server.KeyRoles(*keyId)
+		slices.Sort(got)
+
+		if !slices.Equal(got, want) {
+			return fmt.Errorf("expected key %s to have roles %v, got %v", *keyId, want, got)
+		}
+
+		return nil
+	}
+}
+
+// testAccKeyRolesResourceConfig assigns the roles to a key, or omits the
+// unkey_key_roles resource when there are none.
+func testAccKeyRolesResourceConfig(server *fakeunkey.Server, authoritative bool, roles ...string) string {
+	config := testAccProviderConfig(server) + `
+resource "unkey_api" "test" {
+  name = "payments"
+}
+
+resource "unkey_key" "test" {
+  api_id      = unkey_api.test.id
+  byte_length = 16
+}
+
+resource "unkey_role" "reader" {
+  name = "reader"
+}
+
+resource "unkey_role" "writer" {
+  name = "writer"
+}
+
+resource "unkey_role" "admin" {
+  name = "admin"
+}
+`
+
+	if len(roles) == 0 {
+		return config
+	}
+
+	return config + fmt.Sprintf(`
+resource "unkey_key_roles" "test" {
+  key_id        = unkey_key.test.id
+  roles         = [%s]
+  authoritative = %t
+}
+`, strings.Join(roles, ", "), authoritative)
+}
+
+// testAccKeyRolesResourceRotationConfig assigns a role to one of two keys,
+// the first of which is rotated whenever version changes.
+func testAccKeyRolesResourceRotationConfig(server *fakeunkey.Server, version, key string) string {
+	return testAccProviderConfig(server) + fmt.Sprintf(`
+resource "unkey_api" "test" {
+  name = "payments"
+}
+
+resource "unkey_key" "test" {
+  api_id      = unkey_api.test.id
+  byte_length = 16
+
+  rotation = {
+    rotate_when_changed = {
+      version = %q
+    }
+    overlap = 86400000
+  }
+}
+
+resource "unkey_key" "other" {
+  api_id      = unkey_api.test.id
+  byte_length = 16
+}
+
+resource "unkey_role" "reader" {
+  name = "reader"
+}
+
+resource "unkey_key_roles" "test" {
+  key_id = unkey_key.%s.id
+  roles  = [unkey_role.reader.name]
+}
+`, version, key)
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/conversions"
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/models"
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/unkeyed/sdks/api/go/v2/models/components"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &keysDataSource{}
+	_ datasource.DataSourceWithConfigure = &keysDataSource{}
+)
+
+// NewKeysDataSource is a helper function to simplify the provider implementation.
+func NewKeysDataSource() datasource.DataSource {
+	return &keysDataSource{}
+}
+
+// keysDataSource is the data source implementation.
+type keysDataSource struct {
+	client *unkeyClient
+}
+
+// Metadata returns the data source type name.
+func (d *keysDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_keys"
+}
+
+// Schema defines the schema for the data source.
+func (d *keysDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schemas.KeysDataSourceSchema()
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *keysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get filters from configuration
+	var state models.KeysDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.client.requestTimeout)
+	defer cancel()
+
+	apiId := state.ApiId.ValueString()
+	prefix := state.Prefix.ValueString()
+	limit := int64(100)
+
+	state.Keys = []models.KeyListItemModel{}
+
+	// Follow the pagination cursor until every key has been read
+	var cursor *string
+	for {
+		list, err := d.client.Apis.ListKeys(ctx, components.V2ApisListKeysRequestBody{
+			APIID:      apiId,
+			Limit:      &limit,
+			Cursor:     cursor,
+			ExternalID: state.ExternalId.ValueStringPointer(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to List Unkey Keys",
+				"Could not list keys of Unkey API ID "+apiId+": "+err.Error(),
+			)
+			return
+		}
+
+		body := list.V2ApisListKeysResponseBody
+		for _, key := range body.GetData() {
+			if !strings.HasPrefix(key.Start, prefix) {
+				continue
+			}
+
+			item := models.KeyListItemModel{
+				KeyId:   types.StringValue(key.KeyID),
+				Start:   types.StringValue(key.Start),
+				Name:    types.StringPointerValue(key.Name),
+				Expires: types.Int64PointerValue(key.Expires),
+				Enabled: types.BoolValue(key.Enabled),
+			}
+
+			if key.Identity != nil {
+				item.ExternalId = types.StringValue(key.Identity.ExternalID)
+			} else {
+				item.ExternalId = types.StringNull()
+			}
+
+			item.Roles, diags = conversions.SliceToStringList(ctx, key.Roles)
+			resp.Diagnostics.Append(diags...)
+
+			item.Permissions, diags = conversions.SliceToStringList(ctx, key.Permissions)
+			resp.Diagnostics.Append(diags...)
+
+			state.Keys = append(state.Keys, item)
+		}
+
+		pagination := body.GetPagination()
+		if !pagination.GetHasMore() {
+			break
+		}
+		if pagination.GetCursor() == nil {
+			resp.Diagnostics.AddError(
+				"Unable to List Unkey Keys",
+				"Could not list keys of Unkey API ID "+apiId+": "+errMissingCursor.Error(),
+			)
+			return
+		}
+		cursor = pagination.GetCursor()
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Listed Unkey keys", map[string]any{"api_id": apiId, "count": len(state.Keys)})
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *keysDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unkeyClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.unkeyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
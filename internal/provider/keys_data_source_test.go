@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/fakeunkey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccKeysDataSource(t *testing.T) {
+	server := fakeunkey.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeysDataSourceConfig(server),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.unkey_keys.all", "keys.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair("data.unkey_keys.all", "keys.*.id", "unkey_key.live", "id"),
+					resource.TestCheckTypeSetElemAttrPair("data.unkey_keys.all", "keys.*.id", "unkey_key.test", "id"),
+					// The prefix filter matches the start of the key
+					resource.TestCheckResourceAttr("data.unkey_keys.live", "keys.#", "1"),
+					resource.TestCheckResourceAttrPair("data.unkey_keys.live", "keys.0.id", "unkey_key.live", "id"),
+					resource.TestCheckResourceAttr("data.unkey_keys.live", "keys.0.name", "live"),
+					resource.TestCheckResourceAttr("data.unkey_keys.live", "keys.0.roles.#", "1"),
+					resource.TestCheckResourceAttr("data.unkey_keys.live", "keys.0.roles.0", "reader"),
+					resource.TestCheckResourceAttr("data.unkey_keys.live", "keys.0.enabled", "true"),
+					// The external ID filter matches the identity of the key
+					resource.TestCheckResourceAttr("data.unkey_keys.user", "keys.#", "1"),
+					resource.TestCheckResourceAttrPair("data.unkey_keys.user", "keys.0.id", "unkey_key.test", "id"),
+					resource.TestCheckResourceAttr("data.unkey_keys.user", "keys.0.external_id", "user_123"),
+					resource.TestCheckResourceAttr("data.unkey_keys.user", "keys.0.permissions.#", "1"),
+					resource.TestCheckResourceAttr("data.unkey_keys.user", "keys.0.permissions.0", "documents.read"),
+				),
+			},
+			// An API that does not exist fails the read
+			{
+				Config:      testAccKeysDataSourceMissingConfig(server),
+				ExpectError: regexp.MustCompile("Unable to List Unkey Keys"),
+			},
+		},
+	})
+}
+
+func testAccKeysDataSourceConfig(server *fakeunkey.Server) string {
+	return testAccProviderConfig(server) + `
+resource "unkey_api" "test" {
+  name = "payments"
+}
+
+resource "unkey_role" "reader" {
+  name = "reader"
+}
+
+resource "unkey_key" "live" {
+  api_id = unkey_api.test.id
+  prefix = "live"
+  name   = "live"
+  roles  = [unkey_role.reader.name]
+}
+
+resource "unkey_key" "test" {
+  api_id      = unkey_api.test.id
+  prefix      = "test"
+  name        = "test"
+  external_id = "user_123"
+  permissions = ["documents.read"]
+}
+
+data "unkey_keys" "all" {
+  api_id = unkey_api.test.id
+
+  depends_on = [unkey_key.live, unkey_key.test]
+}
+
+data "unkey_keys" "live" {
+  api_id = unkey_api.test.id
+  prefix = "live_"
+
+  depends_on = [unkey_key.live, unkey_key.test]
+}
+
+data "unkey_keys" "user" {
+  api_id      = unkey_api.test.id
+  external_id = "user_123"
+
+  depends_on = [unkey_key.live, unkey_key.test]
+}
+`
+}
+
+func testAccKeysDataSourceMissingConfig(server *fakeunkey.Server) string {
+	return testAccProviderConfig(server) + `
+data "unkey_keys" "test" {
+  api_id = "api_missing"
+}
+`
+}
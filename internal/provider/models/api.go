@@ -1,8 +1,17 @@
 package models
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 type ApiResourceModel struct {
+	ApiId    types.String   `tfsdk:"id"`
+	Name     types.String   `tfsdk:"name"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type ApiDataSourceModel struct {
 	ApiId types.String `tfsdk:"id"`
 	Name  types.String `tfsdk:"name"`
 }
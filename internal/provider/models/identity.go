@@ -1,10 +1,23 @@
 package models
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 type IdentityResourceModel struct {
+	IdentityId types.String   `tfsdk:"id"`
+	ExternalId types.String   `tfsdk:"external_id"`
+	Meta       types.String   `tfsdk:"meta"`
+	Ratelimits types.List     `tfsdk:"ratelimits"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+type IdentityDataSourceModel struct {
 	IdentityId types.String `tfsdk:"id"`
 	ExternalId types.String `tfsdk:"external_id"`
 	Meta       types.String `tfsdk:"meta"`
 	Ratelimits types.List   `tfsdk:"ratelimits"`
+	ApiId      types.String `tfsdk:"api_id"`
+	Keys       types.List   `tfsdk:"keys"`
 }
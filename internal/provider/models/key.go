@@ -1,24 +1,88 @@
 package models
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+var KeyRotationAttrTypes = map[string]attr.Type{
+	"rotate_when_changed": types.MapType{ElemType: types.StringType},
+	"rotation_days":       types.Int64Type,
+	"overlap":             types.Int64Type,
+}
+
 type KeyResourceModel struct {
-	KeyId             types.String `tfsdk:"id"`
-	Key               types.String `tfsdk:"key"`
-	ApiId             types.String `tfsdk:"api_id"`
-	Prefix            types.String `tfsdk:"prefix"`
-	Name              types.String `tfsdk:"name"`
-	ByteLength        types.Int64  `tfsdk:"byte_length"`
-	ExternalId        types.String `tfsdk:"external_id"`
-	Meta              types.String `tfsdk:"meta"`
-	Roles             types.List   `tfsdk:"roles"`
-	Permissions       types.List   `tfsdk:"permissions"`
-	Expires           types.Int64  `tfsdk:"expires"`
-	Credits           types.Object `tfsdk:"credits"`
-	Ratelimits        types.List   `tfsdk:"ratelimits"`
-	Enabled           types.Bool   `tfsdk:"enabled"`
-	Recoverable       types.Bool   `tfsdk:"recoverable"`
-	PermanentDeletion types.Bool   `tfsdk:"permanent_deletion"`
+	KeyId             types.String   `tfsdk:"id"`
+	Key               types.String   `tfsdk:"key"`
+	ApiId             types.String   `tfsdk:"api_id"`
+	Prefix            types.String   `tfsdk:"prefix"`
+	Name              types.String   `tfsdk:"name"`
+	ByteLength        types.Int64    `tfsdk:"byte_length"`
+	ExternalId        types.String   `tfsdk:"external_id"`
+	Meta              types.String   `tfsdk:"meta"`
+	Roles             types.List     `tfsdk:"roles"`
+	Permissions       types.List     `tfsdk:"permissions"`
+	Expires           types.Int64    `tfsdk:"expires"`
+	Credits           types.Object   `tfsdk:"credits"`
+	Ratelimits        types.List     `tfsdk:"ratelimits"`
+	Enabled           types.Bool     `tfsdk:"enabled"`
+	Recoverable       types.Bool     `tfsdk:"recoverable"`
+	PermanentDeletion types.Bool     `tfsdk:"permanent_deletion"`
+	StoreKeyInState   types.Bool     `tfsdk:"store_key_in_state"`
+	PgpKey            types.String   `tfsdk:"pgp_key"`
+	AgeRecipient      types.String   `tfsdk:"age_recipient"`
+	EncryptedKey      types.String   `tfsdk:"encrypted_key"`
+	KeyFingerprint    types.String   `tfsdk:"key_fingerprint"`
+	Plaintext         types.String   `tfsdk:"plaintext"`
+	PlaintextVersion  types.Int64    `tfsdk:"plaintext_version"`
+	MigrationId       types.String   `tfsdk:"migration_id"`
+	Rotation          types.Object   `tfsdk:"rotation"`
+	PreviousKeyId     types.String   `tfsdk:"previous_key_id"`
+	RotatedAt         types.Int64    `tfsdk:"rotated_at"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+type KeyRotationModel struct {
+	RotateWhenChanged types.Map   `tfsdk:"rotate_when_changed"`
+	RotationDays      types.Int64 `tfsdk:"rotation_days"`
+	Overlap           types.Int64 `tfsdk:"overlap"`
+}
+
+type KeyDataSourceModel struct {
+	KeyId       types.String `tfsdk:"id"`
+	Start       types.String `tfsdk:"start"`
+	Name        types.String `tfsdk:"name"`
+	ExternalId  types.String `tfsdk:"external_id"`
+	Meta        types.String `tfsdk:"meta"`
+	Roles       types.List   `tfsdk:"roles"`
+	Permissions types.List   `tfsdk:"permissions"`
+	Expires     types.Int64  `tfsdk:"expires"`
+	Credits     types.Object `tfsdk:"credits"`
+	Ratelimits  types.List   `tfsdk:"ratelimits"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+}
+
+type KeyPlaintextEphemeralModel struct {
+	KeyId types.String `tfsdk:"id"`
+	Start types.String `tfsdk:"start"`
+	Key   types.String `tfsdk:"key"`
+}
+
+type KeysDataSourceModel struct {
+	ApiId      types.String       `tfsdk:"api_id"`
+	ExternalId types.String       `tfsdk:"external_id"`
+	Prefix     types.String       `tfsdk:"prefix"`
+	Keys       []KeyListItemModel `tfsdk:"keys"`
+}
+
+type KeyListItemModel struct {
+	KeyId       types.String `tfsdk:"id"`
+	Start       types.String `tfsdk:"start"`
+	Name        types.String `tfsdk:"name"`
+	ExternalId  types.String `tfsdk:"external_id"`
+	Roles       types.List   `tfsdk:"roles"`
+	Permissions types.List   `tfsdk:"permissions"`
+	Expires     types.Int64  `tfsdk:"expires"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
 }
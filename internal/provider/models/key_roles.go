@@ -0,0 +1,14 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type KeyRolesResourceModel struct {
+	Id            types.String   `tfsdk:"id"`
+	KeyId         types.String   `tfsdk:"key_id"`
+	Roles         types.Set      `tfsdk:"roles"`
+	Authoritative types.Bool     `tfsdk:"authoritative"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
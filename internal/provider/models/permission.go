@@ -1,12 +1,27 @@
 package models
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type PermissionResourceModel struct {
+	PermissionId types.String   `tfsdk:"id"`
+	Name         types.String   `tfsdk:"name"`
+	Slug         types.String   `tfsdk:"slug"`
+	Description  types.String   `tfsdk:"description"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+type PermissionDataSourceModel struct {
 	PermissionId types.String `tfsdk:"id"`
 	Name         types.String `tfsdk:"name"`
 	Slug         types.String `tfsdk:"slug"`
 	Description  types.String `tfsdk:"description"`
 }
+
+type PermissionsDataSourceModel struct {
+	NamePrefix  types.String                `tfsdk:"name_prefix"`
+	SlugPrefix  types.String                `tfsdk:"slug_prefix"`
+	Permissions []PermissionDataSourceModel `tfsdk:"permissions"`
+}
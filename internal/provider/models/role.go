@@ -1,11 +1,26 @@
 package models
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type RoleResourceModel struct {
+	RoleId      types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Permissions types.List     `tfsdk:"permissions"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+type RoleDataSourceModel struct {
 	RoleId      types.String `tfsdk:"id"`
 	Name        types.String `tfsdk:"name"`
 	Description types.String `tfsdk:"description"`
+	Permissions types.List   `tfsdk:"permissions"`
+}
+
+type RolesDataSourceModel struct {
+	NamePrefix types.String          `tfsdk:"name_prefix"`
+	Roles      []RoleDataSourceModel `tfsdk:"roles"`
 }
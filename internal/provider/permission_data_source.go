@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/models"
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unkeyed/sdks/api/go/v2/models/components"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &permissionDataSource{}
+	_ datasource.DataSourceWithConfigure = &permissionDataSource{}
+)
+
+// NewPermissionDataSource is a helper function to simplify the provider implementation.
+func NewPermissionDataSource() datasource.DataSource {
+	return &permissionDataSource{}
+}
+
+// permissionDataSource is the data source implementation.
+type permissionDataSource struct {
+	client *unkeyClient
+}
+
+// Metadata returns the data source type name.
+func (d *permissionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permission"
+}
+
+// Schema defines the schema for the data source.
+func (d *permissionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schemas.PermissionDataSourceSchema()
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *permissionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get lookup values from configuration
+	var state models.PermissionDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.client.requestTimeout)
+	defer cancel()
+
+	var data *components.Permission
+
+	if !state.PermissionId.IsNull() {
+		permission, err := d.client.Permissions.GetPermission(ctx, components.V2PermissionsGetPermissionRequestBody{
+			Permission: state.PermissionId.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Unkey Permission",
+				"Could not read Unkey Permission ID "+state.PermissionId.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+
+		found := permission.V2PermissionsGetPermissionResponseBody.GetData()
+		data = &found
+	} else {
+		// Unkey only reads permissions by ID, so look up the slug or name
+		bySlug := !state.Slug.IsNull()
+		lookup := state.Slug.ValueString()
+		if !bySlug {
+			lookup = state.Name.ValueString()
+		}
+
+		permissions, err := listPermissions(ctx, d.client, func(permission components.Permission) bool {
+			if bySlug {
+				return permission.Slug == lookup
+			}
+			return permission.Name == lookup
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to List Unkey Permissions",
+				"Could not list Unkey Permissions: "+err.Error(),
+			)
+			return
+		}
+		if len(permissions) == 0 {
+			resp.Diagnostics.AddError(
+				"Unkey Permission Not Found",
+				"No permission with the slug or name "+lookup+" exists in the workspace.",
+			)
+			return
+		}
+
+		data = &permissions[0]
+	}
+
+	// Map response body to model
+	state.PermissionId = types.StringValue(data.ID)
+	state.Name = types.StringValue(data.Name)
+	state.Slug = types.StringValue(data.Slug)
+	state.Description = types.StringPointerValue(data.Description)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *permissionDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unkeyClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.unkeyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/fakeunkey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPermissionDataSource(t *testing.T) {
+	server := fakeunkey.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionDataSourceConfig(server),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Looked up by ID
+					resource.TestCheckResourceAttrPair("data.unkey_permission.by_id", "id", "unkey_permission.test", "id"),
+					resource.TestCheckResourceAttr("data.unkey_permission.by_id", "name", "Read documents"),
+					resource.TestCheckResourceAttr("data.unkey_permission.by_id", "slug", "documents.read"),
+					resource.TestCheckResourceAttr("data.unkey_permission.by_id", "description", "Reads any document"),
+					// Looked up by slug and by name through the list of permissions
+					resource.TestCheckResourceAttrPair("data.unkey_permission.by_slug", "id", "unkey_permission.test", "id"),
+					resource.TestCheckResourceAttr("data.unkey_permission.by_slug", "name", "Read documents"),
+					resource.TestCheckResourceAttrPair("data.unkey_permission.by_name", "id", "unkey_permission.test", "id"),
+					resource.TestCheckResourceAttr("data.unkey_permission.by_name", "slug", "documents.read"),
+				),
+			},
+			// A slug that no permission has fails the read
+			{
+				Config:      testAccPermissionDataSourceMissingConfig(server),
+				ExpectError: regexp.MustCompile("Unkey Permission Not Found"),
+			},
+		},
+	})
+}
+
+func testAccPermissionDataSourceConfig(server *fakeunkey.Server) string {
+	return testAccProviderConfig(server) + `
+resource "unkey_permission" "test" {
+  name        = "Read documents"
+  slug        = "documents.read"
+  description = "Reads any document"
+}
+
+resource "unkey_permission" "other" {
+  name = "Write documents"
+  slug = "documents.write"
+}
+
+data "unkey_permission" "by_id" {
+  id = unkey_permission.test.id
+}
+
+data "unkey_permission" "by_slug" {
+  slug = unkey_permission.test.slug
+
+  depends_on = [unkey_permission.other]
+}
+
+data "unkey_permission" "by_name" {
+  name = unkey_permission.test.name
+
+  depends_on = [unkey_permission.other]
+}
+`
+}
+
+func testAccPermissionDataSourceMissingConfig(server *fakeunkey.Server) string {
+	return testAccProviderConfig(server) + `
+data "unkey_permission" "test" {
+  slug = "documents.missing"
+}
+`
+}
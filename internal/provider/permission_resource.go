@@ -5,19 +5,22 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/models"
 	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	unkey "github.com/unkeyed/sdks/api/go/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/unkeyed/sdks/api/go/v2/models/components"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &permissionResource{}
-	_ resource.ResourceWithConfigure = &permissionResource{}
+	_ resource.Resource                = &permissionResource{}
+	_ resource.ResourceWithConfigure   = &permissionResource{}
+	_ resource.ResourceWithImportState = &permissionResource{}
 )
 
 // NewPermissionResource is a helper function to simplify the provider implementation.
@@ -27,7 +30,7 @@ func NewPermissionResource() resource.Resource {
 
 // permissionResource is the resource implementation.
 type permissionResource struct {
-	client *unkey.Unkey
+	client *unkeyClient
 }
 
 // Metadata returns the resource type name.
@@ -36,8 +39,8 @@ func (r *permissionResource) Metadata(_ context.Context, req resource.MetadataRe
 }
 
 // Schema defines the schema for the resource.
-func (r *permissionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schemas.PermissionSchema()
+func (r *permissionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schemas.PermissionSchema(ctx)
 }
 
 // Create a new resource.
@@ -50,6 +53,15 @@ func (r *permissionResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.client.requestTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	permission, err := r.client.Permissions.CreatePermission(ctx, components.V2PermissionsCreatePermissionRequestBody{
 		Name:        plan.Name.ValueString(),
 		Slug:        plan.Slug.ValueString(),
@@ -84,11 +96,29 @@ func (r *permissionResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, r.client.requestTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed API from Unkey
 	api, err := r.client.Permissions.GetPermission(ctx, components.V2PermissionsGetPermissionRequestBody{
 		Permission: state.PermissionId.ValueString(),
 	})
 	if err != nil {
+		// The Permission was deleted outside of Terraform, plan to recreate it
+		if isNotFound(err) {
+			tflog.Warn(ctx, "Unkey Permission not found, removing from state", map[string]any{
+				"id": state.PermissionId.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error Reading Unkey Permission",
 			"Could not read Unkey Permission ID "+state.PermissionId.ValueString()+": "+err.Error(),
@@ -99,6 +129,7 @@ func (r *permissionResource) Read(ctx context.Context, req resource.ReadRequest,
 	data := api.V2PermissionsGetPermissionResponseBody.GetData()
 
 	// Overwrite items with refreshed state
+	state.PermissionId = types.StringValue(data.ID)
 	state.Name = types.StringValue(data.Name)
 	state.Slug = types.StringValue(data.Slug)
 	state.Description = types.StringPointerValue(data.Description)
@@ -111,7 +142,23 @@ func (r *permissionResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 }
 
+// Update only persists the plan: Unkey cannot change a permission in place, so
+// every configurable attribute forces a replacement instead.
 func (r *permissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan models.PermissionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to plan data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 }
 
 func (r *permissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -123,11 +170,25 @@ func (r *permissionResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.client.requestTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete existing Permission
 	_, err := r.client.Permissions.DeletePermission(ctx, components.V2PermissionsDeletePermissionRequestBody{
 		Permission: state.PermissionId.ValueString(),
 	})
 	if err != nil {
+		// Nothing left to delete
+		if isNotFound(err) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error Deleting Unkey Permission",
 			"Could not delete Permission, unexpected error: "+err.Error(),
@@ -136,6 +197,51 @@ func (r *permissionResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 }
 
+// ImportState imports a permission by its ID, or by its slug or name.
+// Unkey only reads permissions by ID, so slugs and names are resolved by
+// listing the permissions of the workspace.
+func (r *permissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	permissionId := req.ID
+
+	if !strings.HasPrefix(permissionId, "perm_") {
+		ctx, cancel := context.WithTimeout(ctx, r.client.requestTimeout)
+		defer cancel()
+
+		permission, err := r.findPermission(ctx, req.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Importing Unkey Permission",
+				"Could not look up Unkey Permission "+req.ID+": "+err.Error(),
+			)
+			return
+		}
+		if permission == nil {
+			resp.Diagnostics.AddError(
+				"Unkey Permission Not Found",
+				"No permission with the slug or name "+req.ID+" exists in the workspace.",
+			)
+			return
+		}
+
+		permissionId = permission.ID
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), permissionId)...)
+}
+
+// findPermission returns the permission of the workspace whose slug or name
+// matches, or nil when there is none.
+func (r *permissionResource) findPermission(ctx context.Context, slugOrName string) (*components.Permission, error) {
+	permissions, err := listPermissions(ctx, r.client, func(permission components.Permission) bool {
+		return permission.Slug == slugOrName || permission.Name == slugOrName
+	})
+	if err != nil || len(permissions) == 0 {
+		return nil, err
+	}
+
+	return &permissions[0], nil
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *permissionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Add a nil check when handling ProviderData because Terraform
@@ -144,12 +250,12 @@ func (r *permissionResource) Configure(_ context.Context, req resource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*unkey.Unkey)
+	client, ok := req.ProviderData.(*unkeyClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *unkey.Unkey, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.unkeyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
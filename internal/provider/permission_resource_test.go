@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/fakeunkey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccPermissionResource(t *testing.T) {
+	server := fakeunkey.NewServer(t)
+
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(server, "unkey_permission"),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccPermissionResourceConfig(server, "Read documents"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unkey_permission.test", "name", "documents.read"),
+					resource.TestCheckResourceAttr("unkey_permission.test", "slug", "documents.read"),
+					resource.TestCheckResourceAttr("unkey_permission.test", "description", "Read documents"),
+					resource.TestCheckResourceAttrSet("unkey_permission.test", "id"),
+					testAccCheckID("unkey_permission.test", &id),
+				),
+			},
+			// ImportState testing by ID
+			{
+				ResourceName:      "unkey_permission.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// ImportState testing by slug
+			{
+				ResourceName:      "unkey_permission.test",
+				ImportState:       true,
+				ImportStateId:     "documents.read",
+				ImportStateVerify: true,
+			},
+			// Drift testing: the permission is recreated after being deleted outside of Terraform
+			{
+				PreConfig: testAccDelete(t, server, &id),
+				Config:    testAccPermissionResourceConfig(server, "Read documents"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("unkey_permission.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+			// Update testing: a permission with a new description is replaced
+			{
+				Config: testAccPermissionResourceConfig(server, "Read any document"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("unkey_permission.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("unkey_permission.test", "description", "Read any document"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccPermissionResourceConfig(server *fakeunkey.Server, description string) string {
+	return testAccProviderConfig(server) + fmt.Sprintf(`
+resource "unkey_permission" "test" {
+  name        = "documents.read"
+  slug        = "documents.read"
+  description = %q
+}
+`, description)
+}
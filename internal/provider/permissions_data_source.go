@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/models"
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unkeyed/sdks/api/go/v2/models/components"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &permissionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &permissionsDataSource{}
+)
+
+// NewPermissionsDataSource is a helper function to simplify the provider implementation.
+func NewPermissionsDataSource() datasource.DataSource {
+	return &permissionsDataSource{}
+}
+
+// permissionsDataSource is the data source implementation.
+type permissionsDataSource struct {
+	client *unkeyClient
+}
+
+// Metadata returns the data source type name.
+func (d *permissionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permissions"
+}
+
+// Schema defines the schema for the data source.
+func (d *permissionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schemas.PermissionsDataSourceSchema()
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *permissionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get filters from configuration
+	var state models.PermissionsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.client.requestTimeout)
+	defer cancel()
+
+	namePrefix := state.NamePrefix.ValueString()
+	slugPrefix := state.SlugPrefix.ValueString()
+
+	permissions, err := listPermissions(ctx, d.client, func(permission components.Permission) bool {
+		return strings.HasPrefix(permission.Name, namePrefix) && strings.HasPrefix(permission.Slug, slugPrefix)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to List Unkey Permissions",
+			"Could not list Unkey Permissions: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Permissions = []models.PermissionDataSourceModel{}
+	for _, permission := range permissions {
+		state.Permissions = append(state.Permissions, models.PermissionDataSourceModel{
+			PermissionId: types.StringValue(permission.ID),
+			Name:         types.StringValue(permission.Name),
+			Slug:         types.StringValue(permission.Slug),
+			Description:  types.StringPointerValue(permission.Description),
+		})
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *permissionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unkeyClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.unkeyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// listPermissions pages through the permissions of the workspace and returns
+// the ones for which keep reports true.
+func listPermissions(ctx context.Context, client *unkeyClient, keep func(components.Permission) bool) ([]components.Permission, error) {
+	permissions := []components.Permission{}
+
+	var cursor *string
+	for {
+		list, err := client.Permissions.ListPermissions(ctx, components.V2PermissionsListPermissionsRequestBody{
+			Cursor: cursor,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		body := list.V2PermissionsListPermissionsResponseBody
+		for _, permission := range body.GetData() {
+			if keep(permission) {
+				permissions = append(permissions, permission)
+			}
+		}
+
+		pagination := body.GetPagination()
+		if !pagination.GetHasMore() {
+			return permissions, nil
+		}
+		if pagination.GetCursor() == nil {
+			return nil, errMissingCursor
+		}
+		cursor = pagination.GetCursor()
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/fakeunkey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPermissionsDataSource(t *testing.T) {
+	server := fakeunkey.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsDataSourceConfig(server),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.unkey_permissions.all", "permissions.#", "3"),
+					// The slug prefix filter matches the start of the slug
+					resource.TestCheckResourceAttr("data.unkey_permissions.documents", "permissions.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.unkey_permissions.documents", "permissions.*", map[string]string{
+						"name":        "Read documents",
+						"slug":        "documents.read",
+						"description": "Reads any document",
+					}),
+					resource.TestCheckTypeSetElemAttrPair("data.unkey_permissions.documents", "permissions.*.id", "unkey_permission.write", "id"),
+					// The name prefix filter matches the start of the name
+					resource.TestCheckResourceAttr("data.unkey_permissions.read", "permissions.#", "1"),
+					resource.TestCheckResourceAttrPair("data.unkey_permissions.read", "permissions.0.id", "unkey_permission.read", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPermissionsDataSourceConfig(server *fakeunkey.Server) string {
+	return testAccProviderConfig(server) + `
+resource "unkey_permission" "read" {
+  name        = "Read documents"
+  slug        = "documents.read"
+  description = "Reads any document"
+}
+
+resource "unkey_permission" "write" {
+  name = "Write documents"
+  slug = "documents.write"
+}
+
+resource "unkey_permission" "billing" {
+  name = "Read billing"
+  slug = "billing.read"
+}
+
+data "unkey_permissions" "all" {
+  depends_on = [unkey_permission.read, unkey_permission.write, unkey_permission.billing]
+}
+
+data "unkey_permissions" "documents" {
+  slug_prefix = "documents."
+
+  depends_on = [unkey_permission.read, unkey_permission.write, unkey_permission.billing]
+}
+
+data "unkey_permissions" "read" {
+  name_prefix = "Read doc"
+
+  depends_on = [unkey_permission.read, unkey_permission.write, unkey_permission.billing]
+}
+`
+}
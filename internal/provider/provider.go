@@ -4,21 +4,44 @@ package provider
 
 import (
 	"context"
+	"net/http"
+	"net/url"
 	"os"
+	"strings"
+	"time"
 
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/transport"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	unkey "github.com/unkeyed/sdks/api/go/v2"
+	"github.com/unkeyed/sdks/api/go/v2/retry"
+	"golang.org/x/time/rate"
 )
 
+// Defaults for retrying rate limited and failed requests.
+const (
+	defaultMaxRetries      = 5
+	defaultRetryMinBackoff = time.Second
+	defaultRetryMaxBackoff = 30 * time.Second
+)
+
+// defaultRequestTimeout bounds operations that do not configure a timeout.
+const defaultRequestTimeout = 5 * time.Minute
+
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &unkeyProvider{}
+	_ provider.Provider                       = &unkeyProvider{}
+	_ provider.ProviderWithEphemeralResources = &unkeyProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -32,7 +55,19 @@ func New(version string) func() provider.Provider {
 
 // unkeyProviderModel maps provider schema data to a Go type.
 type unkeyProviderModel struct {
-	RootKey types.String `tfsdk:"root_key"`
+	RootKey         types.String `tfsdk:"root_key"`
+	RootKeyFile     types.String `tfsdk:"root_key_file"`
+	Profile         types.String `tfsdk:"profile"`
+	BaseURL         types.String `tfsdk:"base_url"`
+	MaxRetries      types.Int64  `tfsdk:"max_retries"`
+	RetryMinBackoff types.String `tfsdk:"retry_min_backoff"`
+	RetryMaxBackoff types.String `tfsdk:"retry_max_backoff"`
+	RequestTimeout  types.String `tfsdk:"request_timeout"`
+	ValidateRootKey types.Bool   `tfsdk:"validate_root_key"`
+	CustomHeaders   types.Map    `tfsdk:"custom_headers"`
+
+	MaxRequestsPerSecond  types.Int64 `tfsdk:"max_requests_per_second"`
+	MaxConcurrentRequests types.Int64 `tfsdk:"max_concurrent_requests"`
 }
 
 // unkeyProvider is the provider implementation.
@@ -55,9 +90,72 @@ func (p *unkeyProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 		Description: "Interact with Unkey.",
 		Attributes: map[string]schema.Attribute{
 			"root_key": schema.StringAttribute{
-				Description: "Root key for Unkey API. May also be provided via UNKEY_ROOT_KEY environment variable.",
+				Description: "Root key for Unkey API. May also be provided via UNKEY_ROOT_KEY environment variable, which is only used when none of root_key, root_key_file and profile is set. " +
+					"Provider configuration is never written to the plan or state, and on Terraform 1.10 and later the root key may come from an ephemeral resource.",
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("root_key_file"), path.MatchRoot("profile")),
+				},
+			},
+			"root_key_file": schema.StringAttribute{
+				Description: "Path of a file containing the root key for Unkey API. Surrounding whitespace is ignored.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("profile")),
+				},
+			},
+			"profile": schema.StringAttribute{
+				Description: "Name of the profile to read the root key from in the credentials file, ~/.unkey/credentials unless overridden by the UNKEY_CREDENTIALS_FILE environment variable. " +
+					"Each profile is a section such as [staging] with a root_key entry. May also be provided via UNKEY_PROFILE environment variable. " +
+					"Without any root key configured, the default profile is used if present.",
+				Optional: true,
+			},
+			"base_url": schema.StringAttribute{
+				Description: "Base URL of the Unkey API, for example to target a self-hosted Unkey. Defaults to " + unkey.ServerList[0] + ". May also be provided via UNKEY_BASE_URL environment variable.",
+				Optional:    true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum number of times a rate limited or failed request is retried. Creates are only retried when rate limited, so they never produce duplicates. Defaults to 5; 0 disables retries.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_min_backoff": schema.StringAttribute{
+				Description: "Wait before the first retry, as a duration such as \"500ms\" or \"1s\". Must be longer than zero. The wait doubles with every retry, and a random part of up to half of it is taken off so parallel operations do not retry at the same time. A Retry-After header sent by Unkey takes precedence. Defaults to 1s.",
+				Optional:    true,
+			},
+			"retry_max_backoff": schema.StringAttribute{
+				Description: "Longest wait between two retries, as a duration such as \"30s\". Defaults to 30s.",
+				Optional:    true,
+			},
+			"custom_headers": schema.MapAttribute{
+				Description: "Extra HTTP headers sent with every request to the Unkey API, for example to pass a proxy. The Authorization and User-Agent headers are set by the provider and cannot be overridden.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"validate_root_key": schema.BoolAttribute{
+				Description: "Whether to check the root key with Unkey when the provider is configured, so an invalid or revoked root key fails before anything is planned. Defaults to false.",
+				Optional:    true,
+			},
+			"max_requests_per_second": schema.Int64Attribute{
+				Description: "Maximum number of requests per second the provider sends to the Unkey API, shared by all resources and data sources. Retries count as requests. Unlimited by default.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Description: "Maximum number of requests the provider has in flight to the Unkey API at once, however many resources Terraform works on in parallel. Unlimited by default.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"request_timeout": schema.StringAttribute{
+				Description: "Deadline for every create, read, update and delete, including retries, as a duration such as \"2m\". Applies to data sources and to resources whose timeouts block does not set the operation. Defaults to 5m.",
 				Optional:    true,
-				Sensitive:   true,
 			},
 		},
 	}
@@ -86,6 +184,42 @@ func (p *unkeyProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		)
 	}
 
+	if config.RootKeyFile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("root_key_file"),
+			"Unknown Unkey API Root Key File",
+			"The provider cannot create the Unkey API client as there is an unknown configuration value for the Unkey API root key file. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the UNKEY_ROOT_KEY environment variable.",
+		)
+	}
+
+	if config.Profile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("profile"),
+			"Unknown Unkey Profile",
+			"The provider cannot create the Unkey API client as there is an unknown configuration value for the Unkey profile. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the UNKEY_PROFILE environment variable.",
+		)
+	}
+
+	if config.CustomHeaders.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("custom_headers"),
+			"Unknown Unkey API Custom Headers",
+			"The provider cannot create the Unkey API client as there is an unknown configuration value for the custom headers. "+
+				"Either target apply the source of the value first, or set the value statically in the configuration.",
+		)
+	}
+
+	if config.BaseURL.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("base_url"),
+			"Unknown Unkey API Base URL",
+			"The provider cannot create the Unkey API client as there is an unknown configuration value for the Unkey API base URL. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the UNKEY_BASE_URL environment variable.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -93,49 +227,191 @@ func (p *unkeyProvider) Configure(ctx context.Context, req provider.ConfigureReq
 	// Default values to environment variables, but override
 	// with Terraform configuration value if set.
 
-	rootKey := os.Getenv("UNKEY_ROOT_KEY")
+	rootKey, rootKeySource := resolveRootKey(config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	if !config.RootKey.IsNull() {
-		rootKey = config.RootKey.ValueString()
+	baseURL := os.Getenv("UNKEY_BASE_URL")
+
+	if !config.BaseURL.IsNull() {
+		baseURL = config.BaseURL.ValueString()
 	}
 
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
-	if rootKey == "" {
+	if rootKey == "" && rootKeySource == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("root_key"),
 			"Missing Unkey API Root Key",
 			"The provider cannot create the Unkey API client as there is a missing or empty value for the Unkey API root key. "+
-				"Set the root key value in the configuration or use the UNKEY_ROOT_KEY environment variable. "+
-				"If either is already set, ensure the value is not empty.",
+				"Set the root_key, root_key_file or profile value in the configuration, or use the UNKEY_ROOT_KEY or UNKEY_PROFILE environment variable.",
+		)
+	} else if rootKey == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("root_key"),
+			"Missing Unkey API Root Key",
+			"The provider cannot create the Unkey API client as the Unkey API root key read from "+rootKeySource+" is empty.",
+		)
+	}
+
+	if baseURL != "" {
+		if parsed, err := url.Parse(baseURL); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("base_url"),
+				"Invalid Unkey API Base URL",
+				"The provider cannot create the Unkey API client as the Unkey API base URL "+baseURL+" is not an absolute http or https URL, such as "+unkey.ServerList[0]+". "+
+					"Correct the base URL value in the configuration or in the UNKEY_BASE_URL environment variable.",
+			)
+		}
+	}
+
+	maxRetries := int64(defaultMaxRetries)
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() {
+		maxRetries = config.MaxRetries.ValueInt64()
+	}
+
+	retryMinBackoff := parseDuration(config.RetryMinBackoff, path.Root("retry_min_backoff"), defaultRetryMinBackoff, &resp.Diagnostics)
+	retryMaxBackoff := parseDuration(config.RetryMaxBackoff, path.Root("retry_max_backoff"), defaultRetryMaxBackoff, &resp.Diagnostics)
+
+	requestTimeout := parseDuration(config.RequestTimeout, path.Root("request_timeout"), defaultRequestTimeout, &resp.Diagnostics)
+	if requestTimeout == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("request_timeout"),
+			"Invalid Request Timeout",
+			"The request_timeout value must be longer than zero.",
+		)
+	}
+
+	if retryMinBackoff == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_min_backoff"),
+			"Invalid Retry Backoff",
+			"The retry_min_backoff value must be longer than zero, so retries do not hit the Unkey API all at once.",
+		)
+	}
+
+	if retryMinBackoff > retryMaxBackoff {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_min_backoff"),
+			"Invalid Retry Backoff",
+			"The retry_min_backoff value "+retryMinBackoff.String()+" must not be longer than the retry_max_backoff value "+retryMaxBackoff.String()+".",
 		)
 	}
 
+	headers := map[string]string{}
+	if !config.CustomHeaders.IsNull() {
+		resp.Diagnostics.Append(config.CustomHeaders.ElementsAs(ctx, &headers, false)...)
+	}
+
+	for name := range headers {
+		if name := http.CanonicalHeaderKey(name); name == "Authorization" || name == "User-Agent" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("custom_headers"),
+				"Invalid Unkey API Custom Header",
+				"The "+name+" header is set by the provider and cannot be overridden through custom_headers.",
+			)
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	ctx = tflog.SetField(ctx, "unkey_root_key", rootKey)
-	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "unkey_root_key")
+	// Keep the root key out of every log, including the HTTP request logs
+	ctx = tflog.MaskAllFieldValuesStrings(ctx, rootKey)
+	ctx = tflog.MaskMessageStrings(ctx, rootKey)
+
+	tflog.Info(ctx, "Using Unkey API root key from "+rootKeySource, map[string]any{"root_key_source": rootKeySource})
 
 	tflog.Debug(ctx, "Creating Unkey client")
 
-	client := unkey.New(
+	// Requests pass the transports from the outside in: retries, the
+	// client-side limits, headers and logging, so every attempt is limited
+	// and logged on its own
+	var roundTripper http.RoundTripper = &transport.LogTransport{
+		Base:    http.DefaultTransport,
+		Secrets: []string{rootKey},
+	}
+
+	roundTripper = &transport.HeaderTransport{
+		Base:      roundTripper,
+		UserAgent: p.userAgent(req.TerraformVersion),
+		Headers:   headers,
+	}
+
+	// One limiter is shared by every resource and data source, as they all
+	// use the same client
+	limits := &transport.LimitTransport{Base: roundTripper}
+	if !config.MaxRequestsPerSecond.IsNull() && !config.MaxRequestsPerSecond.IsUnknown() {
+		limits.Limiter = rate.NewLimiter(rate.Limit(config.MaxRequestsPerSecond.ValueInt64()), 1)
+	}
+	if !config.MaxConcurrentRequests.IsNull() && !config.MaxConcurrentRequests.IsUnknown() {
+		limits.Slots = make(chan struct{}, config.MaxConcurrentRequests.ValueInt64())
+	}
+	roundTripper = limits
+
+	// Retries are handled by the transport rather than by the SDK, because
+	// the SDK retries creates on server errors and never retries rate limits
+	roundTripper = &transport.RetryTransport{
+		Base:       roundTripper,
+		MaxRetries: int(maxRetries),
+		MinBackoff: retryMinBackoff,
+		MaxBackoff: retryMaxBackoff,
+	}
+
+	httpClient := &http.Client{Transport: roundTripper}
+
+	opts := []unkey.SDKOption{
 		unkey.WithSecurity(rootKey),
-	)
+		unkey.WithClient(httpClient),
+		unkey.WithRetryConfig(retry.Config{Strategy: "none"}),
+	}
 
-	// Make the Unkey client available during DataSource and Resource
-	// type Configure methods.
+	serverURL := unkey.ServerList[0]
+	if baseURL != "" {
+		tflog.Debug(ctx, "Using custom Unkey API base URL", map[string]any{"base_url": baseURL})
+		serverURL = strings.TrimSuffix(baseURL, "/")
+		opts = append(opts, unkey.WithServerURL(serverURL))
+	}
+
+	client := &unkeyClient{
+		Unkey:          unkey.New(opts...),
+		requestTimeout: requestTimeout,
+		httpClient:     httpClient,
+		serverURL:      serverURL,
+		rootKey:        rootKey,
+	}
+
+	if config.ValidateRootKey.ValueBool() {
+		validateRootKey(ctx, client, rootKeySource, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Make the Unkey client available during DataSource, Resource and
+	// EphemeralResource type Configure methods.
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
 
 	tflog.Info(ctx, "Configured Unkey client", map[string]any{"success": true})
 }
 
 // DataSources defines the data sources implemented in the provider.
 func (p *unkeyProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewApiDataSource,
+		NewKeyDataSource,
+		NewKeysDataSource,
+		NewIdentityDataSource,
+		NewRoleDataSource,
+		NewRolesDataSource,
+		NewPermissionDataSource,
+		NewPermissionsDataSource,
+	}
 }
 
 // Resources defines the resources implemented in the provider.
@@ -144,7 +420,45 @@ func (p *unkeyProvider) Resources(_ context.Context) []func() resource.Resource
 		NewApiResource,
 		NewIdentityResource,
 		NewKeyResource,
+		NewKeyPermissionsResource,
+		NewKeyRolesResource,
 		NewPermissionResource,
 		NewRoleResource,
 	}
 }
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *unkeyProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewKeyPlaintextEphemeralResource,
+	}
+}
+
+// parseDuration parses an optional duration attribute, falling back to def
+// when it is not set.
+func parseDuration(value types.String, attribute path.Path, def time.Duration, diags *diag.Diagnostics) time.Duration {
+	if value.IsNull() || value.IsUnknown() {
+		return def
+	}
+
+	duration, err := time.ParseDuration(value.ValueString())
+	if err != nil || duration < 0 {
+		diags.AddAttributeError(
+			attribute,
+			"Invalid Duration",
+			"The value "+value.ValueString()+" is not a valid non-negative duration, such as \"500ms\", \"1s\" or \"1m\".",
+		)
+		return def
+	}
+
+	return duration
+}
+
+// userAgent identifies the provider and Terraform versions to Unkey.
+func (p *unkeyProvider) userAgent(terraformVersion string) string {
+	if terraformVersion == "" {
+		terraformVersion = "unknown"
+	}
+
+	return "terraform-provider-unkey/" + p.version + " terraform/" + terraformVersion
+}
@@ -0,0 +1,416 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/fakeunkey"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	unkey "github.com/unkeyed/sdks/api/go/v2"
+	"github.com/unkeyed/sdks/api/go/v2/retry"
+)
+
+// testAccProtoV6ProviderFactories are used to instantiate a provider during
+// acceptance testing. The factory function will be invoked for every Terraform
+// CLI command executed to create a provider server to which the CLI can
+// reattach.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"unkey": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+// testAccProtoV6ProviderFactoriesWithEcho includes the echo provider alongside
+// the unkey provider, so tests can check the data of ephemeral resources.
+var testAccProtoV6ProviderFactoriesWithEcho = map[string]func() (tfprotov6.ProviderServer, error){
+	"unkey": providerserver.NewProtocol6WithError(New("test")()),
+	"echo":  echoprovider.NewProviderServer(),
+}
+
+// testAccProviderConfig configures the provider for the fake Unkey API, so
+// acceptance tests run without network access or an Unkey workspace.
+func testAccProviderConfig(server *fakeunkey.Server) string {
+	return fmt.Sprintf(`
+provider "unkey" {
+  base_url = %q
+  root_key = %q
+}
+`, server.URL, fakeunkey.RootKey)
+}
+
+// testAccCheckID stores the ID of a resource, so a later step can change the
+// resource outside of Terraform.
+func testAccCheckID(name string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", name)
+		}
+
+		*id = rs.Primary.ID
+		return nil
+	}
+}
+
+// testAccCheckDestroyed verifies that no resource of the type is left in the
+// fake Unkey API.
+func testAccCheckDestroyed(server *fakeunkey.Server, resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			if server.Exists(rs.Primary.ID) {
+				return fmt.Errorf("%s %s still exists", resourceType, rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+// testAccDelete returns a PreConfig function that deletes a resource outside
+// of Terraform.
+func testAccDelete(t *testing.T, server *fakeunkey.Server, id *string) func() {
+	return func() {
+		if err := server.Delete(*id); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestAccProvider_validateRootKey(t *testing.T) {
+	server := fakeunkey.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(server, "unkey_api"),
+		Steps: []resource.TestStep{
+			// A root key that Unkey accepts plans and applies as usual
+			{
+				Config: testAccValidateRootKeyConfig(server, fakeunkey.RootKey),
+				Check:  resource.TestCheckResourceAttr("unkey_api.test", "name", "payments"),
+			},
+			// A root key that Unkey rejects fails before anything is planned
+			{
+				Config:      testAccValidateRootKeyConfig(server, "unkey_revoked_root_key"),
+				ExpectError: regexp.MustCompile("Invalid Unkey API Root Key"),
+			},
+		},
+	})
+}
+
+func testAccValidateRootKeyConfig(server *fakeunkey.Server, rootKey string) string {
+	return fmt.Sprintf(`
+provider "unkey" {
+  base_url          = %q
+  root_key          = %q
+  validate_root_key = true
+}
+
+resource "unkey_api" "test" {
+  name = "payments"
+}
+`, server.URL, rootKey)
+}
+
+func TestValidateRootKey(t *testing.T) {
+	server := fakeunkey.NewServer(t)
+
+	tests := map[string]struct {
+		url         string
+		rootKey     string
+		wantError   bool
+		wantWarning bool
+	}{
+		"valid": {
+			url:     server.URL,
+			rootKey: fakeunkey.RootKey,
+		},
+		"rejected": {
+			url:       server.URL,
+			rootKey:   "unkey_revoked_root_key",
+			wantError: true,
+		},
+		// A root key without the permission to list permissions is valid
+		"forbidden": {
+			url:     testStatusServer(t, http.StatusForbidden).URL,
+			rootKey: fakeunkey.RootKey,
+		},
+		"unavailable": {
+			url:         testStatusServer(t, http.StatusServiceUnavailable).URL,
+			rootKey:     fakeunkey.RootKey,
+			wantWarning: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &unkeyClient{
+				Unkey: unkey.New(
+					unkey.WithServerURL(test.url),
+					unkey.WithSecurity(test.rootKey),
+					unkey.WithRetryConfig(retry.Config{Strategy: "none"}),
+				),
+				requestTimeout: 10 * time.Second,
+			}
+
+			var diags diag.Diagnostics
+			validateRootKey(context.Background(), client, "the root_key attribute", &diags)
+
+			if diags.HasError() != test.wantError {
+				t.Errorf("expected error %t, got %v", test.wantError, diags)
+			}
+			if (diags.WarningsCount() > 0) != test.wantWarning {
+				t.Errorf("expected warning %t, got %v", test.wantWarning, diags)
+			}
+		})
+	}
+}
+
+// testStatusServer answers every request with an Unkey error response of the
+// status.
+func testStatusServer(t *testing.T, status int) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		fmt.Fprintf(w, `{"meta":{"requestId":"req_test"},"error":{"title":%q,"detail":"test","status":%d,"type":"https://unkey.com/docs/errors"}}`, http.StatusText(status), status)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestAccProvider_customHeaders(t *testing.T) {
+	server := fakeunkey.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(server, "unkey_api"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomHeadersConfig(server, "X-Proxy-Token"),
+				Check:  resource.TestCheckResourceAttr("unkey_api.test", "name", "payments"),
+			},
+			// The root key and User-Agent cannot be overridden
+			{
+				Config:      testAccCustomHeadersConfig(server, "authorization"),
+				ExpectError: regexp.MustCompile("The Authorization header is set by the provider"),
+			},
+			{
+				Config:      testAccCustomHeadersConfig(server, "User-Agent"),
+				ExpectError: regexp.MustCompile("The User-Agent header is set by the provider"),
+			},
+		},
+	})
+}
+
+func testAccCustomHeadersConfig(server *fakeunkey.Server, header string) string {
+	return fmt.Sprintf(`
+provider "unkey" {
+  base_url = %q
+  root_key = %q
+
+  custom_headers = {
+    %q = "proxy_123"
+  }
+}
+
+resource "unkey_api" "test" {
+  name = "payments"
+}
+`, server.URL, fakeunkey.RootKey, header)
+}
+
+func TestAccProvider_baseURL(t *testing.T) {
+	server := fakeunkey.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(server, "unkey_api"),
+		Steps: []resource.TestStep{
+			// A trailing slash is ignored
+			{
+				Config: testAccProviderSettingsConfig(fmt.Sprintf(`
+  base_url = %q
+  root_key = %q
+`, server.URL+"/", fakeunkey.RootKey)),
+				Check: resource.TestCheckResourceAttr("unkey_api.test", "name", "payments"),
+			},
+			{
+				Config: testAccProviderSettingsConfig(fmt.Sprintf(`
+  base_url = "localhost:8080"
+  root_key = %q
+`, fakeunkey.RootKey)),
+				ExpectError: regexp.MustCompile("Invalid Unkey API Base URL"),
+			},
+		},
+	})
+}
+
+func TestAccProvider_limits(t *testing.T) {
+	server := fakeunkey.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(server, "unkey_api"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderSettingsConfig(fmt.Sprintf(`
+  base_url                = %q
+  root_key                = %q
+  max_requests_per_second = 5
+  max_concurrent_requests = 1
+`, server.URL, fakeunkey.RootKey)),
+				Check: resource.TestCheckResourceAttr("unkey_api.test", "name", "payments"),
+			},
+			{
+				Config: testAccProviderSettingsConfig(fmt.Sprintf(`
+  base_url                = %q
+  root_key                = %q
+  max_requests_per_second = 0
+`, server.URL, fakeunkey.RootKey)),
+				ExpectError: regexp.MustCompile(`(?s)Invalid Attribute Value.*max_requests_per_second`),
+			},
+			{
+				Config: testAccProviderSettingsConfig(fmt.Sprintf(`
+  base_url                = %q
+  root_key                = %q
+  max_concurrent_requests = 0
+`, server.URL, fakeunkey.RootKey)),
+				ExpectError: regexp.MustCompile(`(?s)Invalid Attribute Value.*max_concurrent_requests`),
+			},
+		},
+	})
+}
+
+func TestAccProvider_requestTimeout(t *testing.T) {
+	server := fakeunkey.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(server, "unkey_api"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRequestTimeoutConfig(server, "30s"),
+				Check:  resource.TestCheckResourceAttr("unkey_api.test", "name", "payments"),
+			},
+			{
+				Config:      testAccRequestTimeoutConfig(server, "0s"),
+				ExpectError: regexp.MustCompile("Invalid Request Timeout"),
+			},
+			{
+				Config:      testAccRequestTimeoutConfig(server, "soon"),
+				ExpectError: regexp.MustCompile("Invalid Duration"),
+			},
+		},
+	})
+}
+
+func testAccRequestTimeoutConfig(server *fakeunkey.Server, timeout string) string {
+	return testAccProviderSettingsConfig(fmt.Sprintf(`
+  base_url        = %q
+  root_key        = %q
+  request_timeout = %q
+`, server.URL, fakeunkey.RootKey, timeout))
+}
+
+func TestAccProvider_rootKeyFile(t *testing.T) {
+	server := fakeunkey.NewServer(t)
+
+	// Surrounding whitespace, such as the newline left by an editor, is ignored
+	file := filepath.Join(t.TempDir(), "root_key")
+	if err := os.WriteFile(file, []byte(fakeunkey.RootKey+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(server, "unkey_api"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRootKeyFileConfig(server, file),
+				Check:  resource.TestCheckResourceAttr("unkey_api.test", "name", "payments"),
+			},
+			{
+				Config:      testAccRootKeyFileConfig(server, filepath.Join(t.TempDir(), "missing")),
+				ExpectError: regexp.MustCompile("Unable to Read Unkey Root Key File"),
+			},
+		},
+	})
+}
+
+func testAccRootKeyFileConfig(server *fakeunkey.Server, file string) string {
+	return testAccProviderSettingsConfig(fmt.Sprintf(`
+  base_url      = %q
+  root_key_file = %q
+`, server.URL, file))
+}
+
+func TestAccProvider_profile(t *testing.T) {
+	server := fakeunkey.NewServer(t)
+
+	t.Setenv("UNKEY_CREDENTIALS_FILE", testCredentialsFile(t, "[default]\nroot_key = unkey_revoked_root_key\n\n[staging]\nroot_key = "+fakeunkey.RootKey+"\n"))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(server, "unkey_api"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileConfig(server, "staging"),
+				Check:  resource.TestCheckResourceAttr("unkey_api.test", "name", "payments"),
+			},
+			{
+				Config:      testAccProfileConfig(server, "production"),
+				ExpectError: regexp.MustCompile("Missing Unkey Profile"),
+			},
+		},
+	})
+}
+
+func testAccProfileConfig(server *fakeunkey.Server, profile string) string {
+	return testAccProviderSettingsConfig(fmt.Sprintf(`
+  base_url = %q
+  profile  = %q
+`, server.URL, profile))
+}
+
+// testAccProviderSettingsConfig configures the provider with the settings and
+// creates an API, so every request the provider sends uses them.
+func testAccProviderSettingsConfig(settings string) string {
+	return `
+provider "unkey" {` + settings + `}
+
+resource "unkey_api" "test" {
+  name = "payments"
+}
+`
+}
+
+func TestUserAgent(t *testing.T) {
+	p := &unkeyProvider{version: "1.2.0"}
+
+	tests := map[string]string{
+		"1.10.0": "terraform-provider-unkey/1.2.0 terraform/1.10.0",
+		"":       "terraform-provider-unkey/1.2.0 terraform/unknown",
+	}
+
+	for terraformVersion, want := range tests {
+		if got := p.userAgent(terraformVersion); got != want {
+			t.Errorf("expected %q for Terraform version %q, got %q", want, terraformVersion, got)
+		}
+	}
+}
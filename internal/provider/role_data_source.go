@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/conversions"
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/models"
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unkeyed/sdks/api/go/v2/models/components"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &roleDataSource{}
+	_ datasource.DataSourceWithConfigure = &roleDataSource{}
+)
+
+// NewRoleDataSource is a helper function to simplify the provider implementation.
+func NewRoleDataSource() datasource.DataSource {
+	return &roleDataSource{}
+}
+
+// roleDataSource is the data source implementation.
+type roleDataSource struct {
+	client *unkeyClient
+}
+
+// Metadata returns the data source type name.
+func (d *roleDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+// Schema defines the schema for the data source.
+func (d *roleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schemas.RoleDataSourceSchema()
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *roleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get lookup values from configuration
+	var state models.RoleDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.client.requestTimeout)
+	defer cancel()
+
+	// Unkey resolves both the role ID and the role name
+	lookup := state.RoleId.ValueString()
+	if lookup == "" {
+		lookup = state.Name.ValueString()
+	}
+
+	role, err := d.client.Permissions.GetRole(ctx, components.V2PermissionsGetRoleRequestBody{
+		Role: lookup,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Unkey Role",
+			"Could not read Unkey Role "+lookup+": "+err.Error(),
+		)
+		return
+	}
+
+	data := role.V2PermissionsGetRoleResponseBody.GetData()
+
+	// Map response body to model
+	state.RoleId = types.StringValue(data.ID)
+	state.Name = types.StringValue(data.Name)
+	state.Description = types.StringPointerValue(data.Description)
+
+	state.Permissions, diags = conversions.PermissionSlugsFromAPI(ctx, data.Permissions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *roleDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unkeyClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.unkeyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/fakeunkey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRoleDataSource(t *testing.T) {
+	server := fakeunkey.NewServer(t)
+
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleDataSourceConfig(server),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Looked up by ID
+					resource.TestCheckResourceAttrPair("data.unkey_role.by_id", "id", "unkey_role.test", "id"),
+					resource.TestCheckResourceAttr("data.unkey_role.by_id", "name", "reader"),
+					resource.TestCheckResourceAttr("data.unkey_role.by_id", "description", "Reads documents"),
+					resource.TestCheckNoResourceAttr("data.unkey_role.by_id", "permissions"),
+					// Looked up by name
+					resource.TestCheckResourceAttrPair("data.unkey_role.by_name", "id", "unkey_role.test", "id"),
+					testAccCheckID("unkey_role.test", &id),
+				),
+			},
+			// Permissions assigned to the role are read
+			{
+				PreConfig: func() {
+					if err := server.SetRolePermissions(id, "documents.read"); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccRoleDataSourceConfig(server),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.unkey_role.by_id", "permissions.#", "1"),
+					resource.TestCheckResourceAttr("data.unkey_role.by_id", "permissions.0", "documents.read"),
+				),
+			},
+			// A role that does not exist fails the read
+			{
+				Config:      testAccRoleDataSourceMissingConfig(server),
+				ExpectError: regexp.MustCompile("Unable to Read Unkey Role"),
+			},
+		},
+	})
+}
+
+func testAccRoleDataSourceConfig(server *fakeunkey.Server) string {
+	return testAccProviderConfig(server) + `
+resource "unkey_permission" "read" {
+  name = "documents.read"
+  slug = "documents.read"
+}
+
+resource "unkey_role" "test" {
+  name        = "reader"
+  description = "Reads documents"
+}
+
+data "unkey_role" "by_id" {
+  id = unkey_role.test.id
+}
+
+data "unkey_role" "by_name" {
+  name = unkey_role.test.name
+}
+`
+}
+
+func testAccRoleDataSourceMissingConfig(server *fakeunkey.Server) string {
+	return testAccProviderConfig(server) + `
+data "unkey_role" "test" {
+  name = "missing"
+}
+`
+}
@@ -6,18 +6,21 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/conversions"
 	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/models"
 	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	unkey "github.com/unkeyed/sdks/api/go/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/unkeyed/sdks/api/go/v2/models/components"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &roleResource{}
-	_ resource.ResourceWithConfigure = &roleResource{}
+	_ resource.Resource                = &roleResource{}
+	_ resource.ResourceWithConfigure   = &roleResource{}
+	_ resource.ResourceWithImportState = &roleResource{}
 )
 
 // NewRoleResource is a helper function to simplify the provider implementation.
@@ -27,7 +30,7 @@ func NewRoleResource() resource.Resource {
 
 // roleResource is the resource implementation.
 type roleResource struct {
-	client *unkey.Unkey
+	client *unkeyClient
 }
 
 // Metadata returns the resource type name.
@@ -36,8 +39,8 @@ func (r *roleResource) Metadata(_ context.Context, req resource.MetadataRequest,
 }
 
 // Schema defines the schema for the resource.
-func (r *roleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schemas.RoleSchema()
+func (r *roleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schemas.RoleSchema(ctx)
 }
 
 // Create a new resource.
@@ -50,6 +53,15 @@ func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.client.requestTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	role, err := r.client.Permissions.CreateRole(ctx, components.V2PermissionsCreateRoleRequestBody{
 		Name:        plan.Name.ValueString(),
 		Description: plan.Description.ValueStringPointer(),
@@ -65,6 +77,9 @@ func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, r
 	// Map response body to schema and populate Computed attribute values
 	plan.RoleId = types.StringValue(role.V2PermissionsCreateRoleResponseBody.GetData().RoleID)
 
+	// A new role has no permissions until they are assigned in the dashboard
+	plan.Permissions = types.ListNull(types.StringType)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -83,11 +98,29 @@ func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, r.client.requestTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed API from Unkey
 	api, err := r.client.Permissions.GetRole(ctx, components.V2PermissionsGetRoleRequestBody{
 		Role: state.RoleId.ValueString(),
 	})
 	if err != nil {
+		// The Role was deleted outside of Terraform, plan to recreate it
+		if isNotFound(err) {
+			tflog.Warn(ctx, "Unkey Role not found, removing from state", map[string]any{
+				"id": state.RoleId.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error Reading Unkey Role",
 			"Could not read Unkey Role ID "+state.RoleId.ValueString()+": "+err.Error(),
@@ -98,9 +131,13 @@ func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	data := api.V2PermissionsGetRoleResponseBody.GetData()
 
 	// Overwrite items with refreshed state
+	state.RoleId = types.StringValue(data.ID)
 	state.Name = types.StringValue(data.Name)
 	state.Description = types.StringPointerValue(data.Description)
 
+	state.Permissions, diags = conversions.PermissionSlugsFromAPI(ctx, data.Permissions)
+	resp.Diagnostics.Append(diags...)
+
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -109,7 +146,23 @@ func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 }
 
+// Update only persists the plan: Unkey cannot change a role in place, so
+// every configurable attribute forces a replacement instead.
 func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan models.RoleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to plan data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 }
 
 func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -121,11 +174,25 @@ func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.client.requestTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete existing Role
 	_, err := r.client.Permissions.DeleteRole(ctx, components.V2PermissionsDeleteRoleRequestBody{
 		Role: state.RoleId.ValueString(),
 	})
 	if err != nil {
+		// Nothing left to delete
+		if isNotFound(err) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error Deleting Unkey Role",
 			"Could not delete Role, unexpected error: "+err.Error(),
@@ -134,6 +201,12 @@ func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 }
 
+// ImportState imports a role by its ID or by its name, both of which are
+// accepted by Unkey when reading the role.
+func (r *roleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *roleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Add a nil check when handling ProviderData because Terraform
@@ -142,12 +215,12 @@ func (r *roleResource) Configure(_ context.Context, req resource.ConfigureReques
 		return
 	}
 
-	client, ok := req.ProviderData.(*unkey.Unkey)
+	client, ok := req.ProviderData.(*unkeyClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *unkey.Unkey, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.unkeyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
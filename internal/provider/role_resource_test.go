@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/fakeunkey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccRoleResource(t *testing.T) {
+	server := fakeunkey.NewServer(t)
+
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(server, "unkey_role"),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRoleResourceConfig(server, "Reads documents"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unkey_role.test", "name", "reader"),
+					resource.TestCheckResourceAttr("unkey_role.test", "description", "Reads documents"),
+					resource.TestCheckNoResourceAttr("unkey_role.test", "permissions"),
+					resource.TestCheckResourceAttrSet("unkey_role.test", "id"),
+					testAccCheckID("unkey_role.test", &id),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "unkey_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Drift testing: permissions assigned in the dashboard are read back
+			{
+				PreConfig: func() {
+					if err := server.SetRolePermissions(id, "documents.read"); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccRoleResourceConfig(server, "Reads documents"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unkey_role.test", "permissions.#", "1"),
+					resource.TestCheckResourceAttr("unkey_role.test", "permissions.0", "documents.read"),
+				),
+			},
+			// Drift testing: the role is recreated after being deleted outside of Terraform
+			{
+				PreConfig: testAccDelete(t, server, &id),
+				Config:    testAccRoleResourceConfig(server, "Reads documents"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("unkey_role.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+			// Update testing: a role with a new description is replaced
+			{
+				Config: testAccRoleResourceConfig(server, "Reads all documents"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("unkey_role.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("unkey_role.test", "description", "Reads all documents"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRoleResourceConfig(server *fakeunkey.Server, description string) string {
+	return testAccProviderConfig(server) + fmt.Sprintf(`
+resource "unkey_permission" "read" {
+  name = "documents.read"
+  slug = "documents.read"
+}
+
+resource "unkey_role" "test" {
+  name        = "reader"
+  description = %q
+}
+`, description)
+}
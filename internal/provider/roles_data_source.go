@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/conversions"
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/models"
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/provider/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unkeyed/sdks/api/go/v2/models/components"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &rolesDataSource{}
+	_ datasource.DataSourceWithConfigure = &rolesDataSource{}
+)
+
+// NewRolesDataSource is a helper function to simplify the provider implementation.
+func NewRolesDataSource() datasource.DataSource {
+	return &rolesDataSource{}
+}
+
+// rolesDataSource is the data source implementation.
+type rolesDataSource struct {
+	client *unkeyClient
+}
+
+// Metadata returns the data source type name.
+func (d *rolesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_roles"
+}
+
+// Schema defines the schema for the data source.
+func (d *rolesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schemas.RolesDataSourceSchema()
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *rolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get filters from configuration
+	var state models.RolesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.client.requestTimeout)
+	defer cancel()
+
+	namePrefix := state.NamePrefix.ValueString()
+
+	roles, err := listRoles(ctx, d.client, func(role components.Role) bool {
+		return strings.HasPrefix(role.Name, namePrefix)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to List Unkey Roles",
+			"Could not list Unkey Roles: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Roles = []models.RoleDataSourceModel{}
+	for _, role := range roles {
+		permissions, diags := conversions.PermissionSlugsFromAPI(ctx, role.Permissions)
+		resp.Diagnostics.Append(diags...)
+
+		state.Roles = append(state.Roles, models.RoleDataSourceModel{
+			RoleId:      types.StringValue(role.ID),
+			Name:        types.StringValue(role.Name),
+			Description: types.StringPointerValue(role.Description),
+			Permissions: permissions,
+		})
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *rolesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unkeyClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.unkeyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// listRoles pages through the roles of the workspace and returns the ones
+// for which keep reports true.
+func listRoles(ctx context.Context, client *unkeyClient, keep func(components.Role) bool) ([]components.Role, error) {
+	roles := []components.Role{}
+
+	var cursor *string
+	for {
+		list, err := client.Permissions.ListRoles(ctx, components.V2PermissionsListRolesRequestBody{
+			Cursor: cursor,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		body := list.V2PermissionsListRolesResponseBody
+		for _, role := range body.GetData() {
+			if keep(role) {
+				roles = append(roles, role)
+			}
+		}
+
+		pagination := body.GetPagination()
+		if !pagination.GetHasMore() {
+			return roles, nil
+		}
+		if pagination.GetCursor() == nil {
+			return nil, errMissingCursor
+		}
+		cursor = pagination.GetCursor()
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/TeamEyesoft/terraform-provider-unkey/internal/fakeunkey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRolesDataSource(t *testing.T) {
+	server := fakeunkey.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRolesDataSourceConfig(server),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.unkey_roles.all", "roles.#", "3"),
+					// The name prefix filter matches the start of the name
+					resource.TestCheckResourceAttr("data.unkey_roles.documents", "roles.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.unkey_roles.documents", "roles.*", map[string]string{
+						"name":        "documents.reader",
+						"description": "Reads documents",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.unkey_roles.documents", "roles.*", map[string]string{
+						"name": "documents.writer",
+					}),
+					resource.TestCheckTypeSetElemAttrPair("data.unkey_roles.documents", "roles.*.id", "unkey_role.reader", "id"),
+					resource.TestCheckResourceAttr("data.unkey_roles.none", "roles.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRolesDataSourceConfig(server *fakeunkey.Server) string {
+	return testAccProviderConfig(server) + `
+resource "unkey_role" "reader" {
+  name        = "documents.reader"
+  description = "Reads documents"
+}
+
+resource "unkey_role" "writer" {
+  name = "documents.writer"
+}
+
+resource "unkey_role" "admin" {
+  name = "admin"
+}
+
+data "unkey_roles" "all" {
+  depends_on = [unkey_role.reader, unkey_role.writer, unkey_role.admin]
+}
+
+data "unkey_roles" "documents" {
+  name_prefix = "documents."
+
+  depends_on = [unkey_role.reader, unkey_role.writer, unkey_role.admin]
+}
+
+data "unkey_roles" "none" {
+  name_prefix = "billing."
+
+  depends_on = [unkey_role.reader, unkey_role.writer, unkey_role.admin]
+}
+`
+}
@@ -1,8 +1,10 @@
 package schemas
 
 import (
+	"context"
 	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -10,9 +12,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
-func ApiSchema() schema.Schema {
+func ApiSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
-		Description: "Manages an API resource.",
+		MarkdownDescription: `Manages an API resource.
+
+## Required Permissions
+
+Your root key needs:
+
+- api.*.create_api (create APIs)
+- api.*.read_api or api.<api_id>.read_api (read the API)
+- api.*.delete_api or api.<api_id>.delete_api (delete the API)`,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: `The unique identifier assigned to the newly created API.
@@ -34,7 +44,9 @@ This identifier is permanent and cannot be changed after creation.`,
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: `Unique identifier for this API namespace within your workspace.
-Use descriptive names like 'payment-service-prod' or 'user-api-dev' to clearly identify purpose and environment.`,
+Use descriptive names like 'payment-service-prod' or 'user-api-dev' to clearly identify purpose and environment.
+
+Unkey does not support renaming an API. Changing this value replaces the API, which permanently deletes every key that belongs to it.`,
 				Required: true,
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(3, 255),
@@ -44,7 +56,17 @@ Use descriptive names like 'payment-service-prod' or 'user-api-dev' to clearly i
 						"must match Unkey API ID requirements (alphanumeric, may include . _ - and must start with a letter)",
 					),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
 	}
 }
@@ -0,0 +1,29 @@
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func ApiDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: `Looks up an existing API by its ID.
+
+Use this data source to create keys in an API that is managed by another Terraform configuration or in the Unkey dashboard.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: `The unique identifier of the API to look up.
+Always begins with 'api_' followed by a unique alphanumeric sequence.`,
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 255),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the API within your workspace.",
+				Computed:    true,
+			},
+		},
+	}
+}
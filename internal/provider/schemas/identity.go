@@ -1,8 +1,10 @@
 package schemas
 
 import (
+	"context"
 	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -12,9 +14,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
-func IdentitySchema() schema.Schema {
+func IdentitySchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
-		Description: "Manages an Identity resource.",
+		MarkdownDescription: `Manages an Identity resource.
+
+## Required Permissions
+
+Your root key needs:
+
+- identity.*.create_identity (create identities)
+- identity.*.read_identity (read identities)
+- identity.*.update_identity (update identities)
+- identity.*.delete_identity (delete identities)`,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: `The id of the Identity.
@@ -128,5 +139,13 @@ Shorter windows provide more frequent resets but may allow large burst usage. Lo
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
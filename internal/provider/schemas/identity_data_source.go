@@ -0,0 +1,68 @@
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func IdentityDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: `Looks up an existing identity by its ID or by its external ID.
+
+Use this data source to attach keys to tenants or users whose identity is provisioned by another Terraform configuration.
+
+## Required Permissions
+
+Your root key needs:
+
+- identity.*.read_identity (read identities)
+- api.*.read_key or api.<api_id>.read_key (only when api_id is set)`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: `The id of the identity to look up.
+Exactly one of id and external_id must be set.`,
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 255),
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("id"),
+						path.MatchRoot("external_id"),
+					),
+				},
+			},
+			"external_id": schema.StringAttribute{
+				MarkdownDescription: `The identifier from your own system that the identity was created with.
+Exactly one of id and external_id must be set.`,
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 255),
+				},
+			},
+			"meta": schema.StringAttribute{
+				Description: "The metadata of the identity, encoded as JSON.",
+				Computed:    true,
+			},
+			"ratelimits": ratelimitsDataSourceAttribute(
+				"The rate limits shared by all keys of the identity.",
+			),
+			"api_id": schema.StringAttribute{
+				MarkdownDescription: `The API whose keys attached to the identity are listed in keys.
+Unkey can only list keys per API, so keys stays null when this is not set.`,
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 255),
+				},
+			},
+			"keys": schema.ListAttribute{
+				Description: "The IDs of the keys in api_id that are attached to the identity.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
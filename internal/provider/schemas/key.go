@@ -1,17 +1,24 @@
 package schemas
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func KeySchema() schema.Schema {
+func KeySchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		MarkdownDescription: `Create a new API key for user authentication and authorization.
 
@@ -43,7 +50,8 @@ Store this ID in your database to reference the key later. This ID is not sensit
 			},
 			"key": schema.StringAttribute{
 				MarkdownDescription: `The full generated API key that should be securely provided to your user.
-SECURITY WARNING: This is the only time you'll receive the complete key - Unkey only stores a securely hashed version. Never log or store this value in your own systems; provide it directly to your end user via secure channels. After this API call completes, this value cannot be retrieved again (unless created with recoverable=true).`,
+SECURITY WARNING: This is the only time you'll receive the complete key - Unkey only stores a securely hashed version. Never log or store this value in your own systems; provide it directly to your end user via secure channels. After this API call completes, this value cannot be retrieved again (unless created with recoverable=true).
+Null when store_key_in_state is false, the key is encrypted with pgp_key or age_recipient, or it was migrated with plaintext.`,
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
@@ -85,7 +93,9 @@ Avoid generic names like "API Key" when managing multiple keys for the same user
 Higher values increase security but result in longer keys that may be more annoying to handle.
 The default 16 bytes provides 2^128 possible combinations, sufficient for most applications.
 Consider 32 bytes for highly sensitive APIs, but avoid values above 64 bytes unless specifically required.`,
-				Required: true,
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(16),
 				Validators: []validator.Int64{
 					int64validator.Between(16, 255),
 				},
@@ -116,7 +126,8 @@ Accepts letters, numbers, underscores, dots, and hyphens for flexible identifier
 				MarkdownDescription: `Assigns existing roles to this key for permission management through role-based access control.
 Roles must already exist in your workspace before assignment.
 During verification, all permissions from assigned roles are checked against requested permissions.
-Roles provide a convenient way to group permissions and apply consistent access patterns across multiple keys.`,
+Roles provide a convenient way to group permissions and apply consistent access patterns across multiple keys.
+Leave unset when the roles of this key are managed with unkey_key_roles.`,
 				Required: false,
 				Optional: true,
 				Validators: []validator.List{
@@ -130,7 +141,8 @@ Roles provide a convenient way to group permissions and apply consistent access
 			"permissions": schema.ListAttribute{
 				MarkdownDescription: `Grants specific permissions directly to this key without requiring role membership.
 Wildcard permissions like 'documents.*' grant access to all sub-permissions including 'documents.read' and 'documents.write'.
-Direct permissions supplement any permissions inherited from assigned roles.`,
+Direct permissions supplement any permissions inherited from assigned roles.
+Leave unset when the permissions of this key are managed with unkey_key_permissions.`,
 				Required: false,
 				Optional: true,
 				Validators: []validator.List{
@@ -277,6 +289,8 @@ Useful for pre-creating keys that will be activated later or for keys requiring
 Most keys should be created with 'enabled=true' for immediate use.`,
 				Required: false,
 				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
 			},
 			"recoverable": schema.BoolAttribute{
 				MarkdownDescription: `Controls whether the plaintext key is stored in an encrypted vault for later retrieval.
@@ -285,6 +299,97 @@ When false, the key value cannot be retrieved after creation for maximum securit
 Only enable for development keys or when key recovery is absolutely necessary.`,
 				Required: false,
 				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"store_key_in_state": schema.BoolAttribute{
+				MarkdownDescription: `Controls whether the generated key is written to the Terraform state as ` + "`key`" + `.
+When set to 'false', ` + "`key`" + ` stays null and anyone with access to the state cannot use the key.
+The key must then be recoverable, so it can be retrieved on demand with the unkey_key_plaintext ephemeral resource, or be encrypted with pgp_key or age_recipient.
+Setting it back to 'true' decrypts the key into the state again.`,
+				Required: false,
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"pgp_key": schema.StringAttribute{
+				MarkdownDescription: `Encrypts the generated key for a PGP public key, so it can be handed to its owner by email or ticket.
+Accepts an ASCII-armored public key, or a base64-encoded binary one such as the result of filebase64.
+The ciphertext is written to ` + "`encrypted_key`" + ` and the plaintext key is never written to the state.
+Changing the public key forces a new key to be created.`,
+				Required: false,
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("age_recipient")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"age_recipient": schema.StringAttribute{
+				MarkdownDescription: `Encrypts the generated key for an age public key, such as age1ql3z7hjy54pw3hyww5ayyfg7zqgvc7w3j2elw8zmrj2kg5sfn9aqmcac8p.
+The ciphertext is written to ` + "`encrypted_key`" + ` and the plaintext key is never written to the state.
+Changing the recipient forces a new key to be created.`,
+				Required: false,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"encrypted_key": schema.StringAttribute{
+				MarkdownDescription: `The generated key encrypted for ` + "`pgp_key`" + ` or ` + "`age_recipient`" + `, as an ASCII-armored message.
+Decrypt it with gpg --decrypt or age --decrypt. Updated whenever the key is rotated.`,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key_fingerprint": schema.StringAttribute{
+				MarkdownDescription: "The fingerprint of the PGP public key, or the age recipient, that `encrypted_key` is encrypted for.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"plaintext": schema.StringAttribute{
+				MarkdownDescription: `An existing key to migrate into Unkey instead of generating one, so clients keep using the key they already have.
+Only its SHA-256 hash is sent to Unkey, through the key migration set up for the workspace in ` + "`migration_id`" + `.
+The key is never written to the plan or the state, and ` + "`key`" + ` stays null.
+Terraform cannot tell when the key changes, so change ` + "`plaintext_version`" + ` to migrate a new one.`,
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("migration_id")),
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("byte_length"),
+						path.MatchRoot("recoverable"),
+						path.MatchRoot("pgp_key"),
+						path.MatchRoot("age_recipient"),
+					),
+				},
+			},
+			"plaintext_version": schema.Int64Attribute{
+				MarkdownDescription: `A version number for ` + "`plaintext`" + `. Changing it forces a new key to be migrated with the current ` + "`plaintext`" + `.`,
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("plaintext")),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"migration_id": schema.StringAttribute{
+				MarkdownDescription: `The key migration that Unkey set up for the workspace, which ` + "`plaintext`" + ` is migrated through.
+Changing it forces a new key to be migrated.`,
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 255),
+					stringvalidator.AlsoRequires(path.MatchRoot("plaintext")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"permanent_deletion": schema.BoolAttribute{
 				MarkdownDescription: `Controls deletion behavior between recoverable soft-deletion and irreversible permanent erasure.
@@ -296,7 +401,70 @@ Permanent deletion cannot be undone and may affect analytics data that reference
 Most applications should use soft deletion to maintain audit trails and prevent accidental data loss.`,
 				Required: false,
 				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"rotation": schema.SingleNestedAttribute{
+				MarkdownDescription: `Rotates the key in place with Unkey's reroll endpoint instead of deleting and recreating it.
+The new key keeps the configuration of the previous one, including its permissions, roles, identity, credits and rate limits, and replaces ` + "`id`" + ` and ` + "`key`" + ` in state.
+Unkey keeps the prefix of the previous key but generates the new one with the default byte length of the API.
+The previous key stays valid for the overlap window, so clients can move to the new key without downtime.
+
+Your root key needs api.*.create_key or api.<api_id>.create_key to rotate keys.`,
+				Required: false,
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"rotate_when_changed": schema.MapAttribute{
+						MarkdownDescription: `Arbitrary values that rotate the key whenever they change.
+Use a version number or a timestamp to rotate keys on demand.`,
+						Required:    false,
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+					"rotation_days": schema.Int64Attribute{
+						MarkdownDescription: `Rotates the key on the first plan after it is this many days old.
+The age is counted from ` + "`rotated_at`" + `.`,
+						Required: false,
+						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"overlap": schema.Int64Attribute{
+						MarkdownDescription: `How long the previous key stays valid after a rotation, in milliseconds.
+Defaults to 0, which revokes the previous key immediately.
+Common values include 3600000 (1 hour), 86400000 (24 hours) and 604800000 (7 days).`,
+						Required: false,
+						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+				},
 			},
+			"previous_key_id": schema.StringAttribute{
+				MarkdownDescription: `The ID of the key this key was rotated from.
+It stays valid until the overlap window of the rotation ends.`,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotated_at": schema.Int64Attribute{
+				MarkdownDescription: "When the current key was created or last rotated, as a Unix timestamp in milliseconds.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
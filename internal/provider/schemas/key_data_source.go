@@ -0,0 +1,132 @@
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func KeyDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: `Reads an existing key without managing it.
+
+The attributes have the same shape as the attributes of the unkey_key resource, so they can be passed on to other resources or used for monitoring. The key itself is never returned.
+
+## Required Permissions
+
+Your root key needs one of:
+
+- api.*.read_key (read keys in any API)
+- api.<api_id>.read_key (read keys in specific API)`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the key to read.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 255),
+				},
+			},
+			"start": schema.StringAttribute{
+				Description: "The first few characters of the key, including its prefix, for identification.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The human-readable name of the key.",
+				Computed:    true,
+			},
+			"external_id": schema.StringAttribute{
+				Description: "The external ID of the identity the key belongs to.",
+				Computed:    true,
+			},
+			"meta": schema.StringAttribute{
+				Description: "The metadata of the key, encoded as JSON.",
+				Computed:    true,
+			},
+			"roles": schema.ListAttribute{
+				Description: "The roles assigned to the key.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"permissions": schema.ListAttribute{
+				Description: "The permissions granted directly to the key.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"expires": schema.Int64Attribute{
+				Description: "When the key expires, as a Unix timestamp in milliseconds.",
+				Computed:    true,
+			},
+			"credits": creditsDataSourceAttribute(),
+			"ratelimits": ratelimitsDataSourceAttribute(
+				"The rate limits applied to the key.",
+			),
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the key is enabled.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// creditsDataSourceAttribute mirrors the credits attribute of the unkey_key
+// resource as a computed attribute.
+func creditsDataSourceAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "The usage credits of the key and their automatic refill configuration.",
+		Computed:    true,
+		Attributes: map[string]schema.Attribute{
+			"remaining": schema.Int64Attribute{
+				Description: "Number of credits remaining (null for unlimited).",
+				Computed:    true,
+			},
+			"refill": schema.SingleNestedAttribute{
+				Description: "Configuration for automatic credit refill behavior.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"interval": schema.StringAttribute{
+						Description: "How often credits are automatically refilled.",
+						Computed:    true,
+					},
+					"amount": schema.Int64Attribute{
+						Description: "Number of credits to add during each refill cycle.",
+						Computed:    true,
+					},
+					"refill_day": schema.Int64Attribute{
+						Description: "Day of the month for monthly refills.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ratelimitsDataSourceAttribute mirrors the ratelimits attribute of the
+// unkey_key and unkey_identity resources as a computed attribute.
+func ratelimitsDataSourceAttribute(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: description,
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Description: "The name of the rate limit.",
+					Computed:    true,
+				},
+				"limit": schema.Int64Attribute{
+					Description: "The maximum number of operations allowed within the time window.",
+					Computed:    true,
+				},
+				"duration": schema.Int64Attribute{
+					Description: "The duration of the rate limit window in milliseconds.",
+					Computed:    true,
+				},
+				"auto_apply": schema.BoolAttribute{
+					Description: "Whether the rate limit is automatically applied when verifying a key.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,76 @@
+package schemas
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func KeyPermissionsSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: `Grants permissions directly to an existing key, independently of the unkey_key resource that manages the key.
+
+In authoritative mode the declared permissions replace every direct permission of the key.
+In additive mode only the declared permissions are added and, when removed from the configuration, revoked again.
+Permissions granted by other configurations are left alone, so several teams can each grant access to the same key.
+Permissions inherited from roles are not affected in either mode.
+
+Do not combine this resource with the permissions attribute of unkey_key for the same key, and use at most one authoritative unkey_key_permissions per key.
+
+## Required Permissions
+
+Your root key needs one of:
+
+- api.*.update_key (update keys in any API)
+- api.<api_id>.update_key (update keys in specific API)`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the key the permissions are granted to.",
+				Computed:    true,
+			},
+			"key_id": schema.StringAttribute{
+				MarkdownDescription: `The ID of the key to grant the permissions to.
+Changing this value grants the permissions to the new key and revokes them from the old one.
+When unkey_key rotates the key, the new key inherits the permissions and the old key keeps them until its overlap ends.`,
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 255),
+				},
+			},
+			"permissions": schema.SetAttribute{
+				MarkdownDescription: `The slugs of the permissions to grant to the key.
+Wildcard permissions like 'documents.*' grant access to all sub-permissions including 'documents.read' and 'documents.write'.`,
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(1000),
+					setvalidator.ValueStringsAre(
+						stringvalidator.LengthBetween(1, 100),
+					),
+				},
+			},
+			"authoritative": schema.BoolAttribute{
+				MarkdownDescription: `Whether the declared permissions are the only direct permissions of the key.
+When true, permissions granted to the key outside of this resource are removed and show up as drift.
+When false (the default), only the declared permissions are managed.`,
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
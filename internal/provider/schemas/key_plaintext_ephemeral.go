@@ -0,0 +1,40 @@
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func KeyPlaintextEphemeralSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: `Decrypts a recoverable key, so it can be passed on to a secret manager without being stored in the Terraform plan or state.
+
+Only keys created with recoverable=true can be decrypted. Ephemeral resources require Terraform 1.10 or later.
+
+## Required Permissions
+
+Your root key needs one of:
+
+- api.*.decrypt_key (decrypt keys in any API)
+- api.<api_id>.decrypt_key (decrypt keys in specific API)`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the key to decrypt.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 255),
+				},
+			},
+			"start": schema.StringAttribute{
+				Description: "The first few characters of the key, including its prefix, for identification.",
+				Computed:    true,
+			},
+			"key": schema.StringAttribute{
+				Description: "The plaintext of the key.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
@@ -0,0 +1,75 @@
+package schemas
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func KeyRolesSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: `Assigns roles to an existing key, independently of the unkey_key resource that manages the key.
+
+In authoritative mode the declared roles replace every role of the key.
+In additive mode only the declared roles are added and, when removed from the configuration, revoked again.
+Roles granted by other configurations are left alone, so several teams can each grant access to the same key.
+
+Do not combine this resource with the roles attribute of unkey_key for the same key, and use at most one authoritative unkey_key_roles per key.
+
+## Required Permissions
+
+Your root key needs one of:
+
+- api.*.update_key (update keys in any API)
+- api.<api_id>.update_key (update keys in specific API)`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the key the roles are assigned to.",
+				Computed:    true,
+			},
+			"key_id": schema.StringAttribute{
+				MarkdownDescription: `The ID of the key to assign the roles to.
+Changing this value assigns the roles to the new key and revokes them from the old one.
+When unkey_key rotates the key, the new key inherits the roles and the old key keeps them until its overlap ends.`,
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 255),
+				},
+			},
+			"roles": schema.SetAttribute{
+				MarkdownDescription: `The names of the roles to assign to the key.
+Roles must already exist in your workspace before assignment.`,
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(100),
+					setvalidator.ValueStringsAre(
+						stringvalidator.LengthBetween(1, 100),
+					),
+				},
+			},
+			"authoritative": schema.BoolAttribute{
+				MarkdownDescription: `Whether the declared roles are the only roles of the key.
+When true, roles assigned to the key outside of this resource are removed and show up as drift.
+When false (the default), only the declared roles are managed.`,
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
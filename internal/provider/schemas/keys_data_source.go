@@ -0,0 +1,90 @@
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func KeysDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: `Lists the keys of an API, for example to audit who has access.
+
+Every page of results is fetched, so the list is complete even for APIs with thousands of keys. The keys themselves are never returned.
+
+## Required Permissions
+
+Your root key needs one of:
+
+- api.*.read_key (read keys in any API)
+- api.<api_id>.read_key (read keys in specific API)`,
+		Attributes: map[string]schema.Attribute{
+			"api_id": schema.StringAttribute{
+				Description: "The API namespace whose keys to list.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 255),
+				},
+			},
+			"external_id": schema.StringAttribute{
+				MarkdownDescription: `Only list keys that belong to the identity with this external ID.
+Must exactly match the external_id the key was created with.`,
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"prefix": schema.StringAttribute{
+				MarkdownDescription: `Only list keys whose start begins with this value, such as the prefix the keys were created with (e.g., prod).
+Unkey does not filter by prefix itself, so every key of the API is still read.`,
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 16),
+				},
+			},
+			"keys": schema.ListNestedAttribute{
+				Description: "The keys that match the filters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique identifier of the key.",
+							Computed:    true,
+						},
+						"start": schema.StringAttribute{
+							Description: "The first few characters of the key, including its prefix, for identification.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The human-readable name of the key.",
+							Computed:    true,
+						},
+						"external_id": schema.StringAttribute{
+							Description: "The external ID of the identity the key belongs to.",
+							Computed:    true,
+						},
+						"roles": schema.ListAttribute{
+							Description: "The roles assigned to the key.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"permissions": schema.ListAttribute{
+							Description: "The permissions granted directly to the key.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"expires": schema.Int64Attribute{
+							Description: "When the key expires, as a Unix timestamp in milliseconds.",
+							Computed:    true,
+						},
+						"enabled": schema.BoolAttribute{
+							Description: "Whether the key is enabled.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
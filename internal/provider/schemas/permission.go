@@ -1,8 +1,10 @@
 package schemas
 
 import (
+	"context"
 	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -10,9 +12,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
-func PermissionSchema() schema.Schema {
+func PermissionSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
-		Description: "Manages a Permission resource.",
+		MarkdownDescription: `Manages a Permission resource.
+
+## Required Permissions
+
+Your root key needs:
+
+- rbac.*.create_permission (create permissions)
+- rbac.*.read_permission (read permissions)
+- rbac.*.delete_permission (delete permissions)`,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Unique identifier of the Permission resource.",
@@ -79,5 +89,12 @@ Consider documenting:
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
 	}
 }
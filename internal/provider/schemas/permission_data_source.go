@@ -0,0 +1,115 @@
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func PermissionDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: `Looks up an existing permission by its ID, slug or name.
+
+Use this data source to check that a permission referenced by unkey_key exists.
+Unkey only reads permissions by ID, so slugs and names are resolved by listing the permissions of the workspace.
+
+## Required Permissions
+
+Your root key needs:
+
+- rbac.*.read_permission (read permissions)`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: `The unique identifier of the permission to look up.
+Exactly one of id, slug and name must be set.`,
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 255),
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("id"),
+						path.MatchRoot("slug"),
+						path.MatchRoot("name"),
+					),
+				},
+			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: `The URL-safe identifier of the permission to look up.
+Exactly one of id, slug and name must be set.`,
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: `The human-readable name of the permission to look up.
+Exactly one of id, slug and name must be set.`,
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 512),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the permission.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func PermissionsDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: `Lists the permissions of the workspace.
+
+Every page of results is fetched, so the list is complete even for large permission catalogs.
+
+## Required Permissions
+
+Your root key needs:
+
+- rbac.*.read_permission (read permissions)`,
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				Description: "Only list permissions whose name begins with this value.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 512),
+				},
+			},
+			"slug_prefix": schema.StringAttribute{
+				Description: "Only list permissions whose slug begins with this value.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"permissions": schema.ListNestedAttribute{
+				Description: "The permissions that match the filters, in the order Unkey returns them.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique identifier of the permission.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The human-readable name of the permission.",
+							Computed:    true,
+						},
+						"slug": schema.StringAttribute{
+							Description: "The URL-safe identifier of the permission.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the permission.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
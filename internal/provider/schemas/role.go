@@ -1,16 +1,29 @@
 package schemas
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func RoleSchema() schema.Schema {
+func RoleSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
-		Description: "Manages a Role resource.",
+		MarkdownDescription: `Manages a Role resource.
+
+## Required Permissions
+
+Your root key needs:
+
+- rbac.*.create_role (create roles)
+- rbac.*.read_role (read roles)
+- rbac.*.delete_role (delete roles)`,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Unique identifier of the Role resource.",
@@ -53,6 +66,23 @@ Consider documenting:
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"permissions": schema.ListAttribute{
+				MarkdownDescription: `The slugs of the permissions currently assigned to this role.
+Unkey does not offer an API to assign permissions to roles, so they are managed in the Unkey dashboard.
+This attribute is read on every refresh, so changes made in the dashboard show up in the state.`,
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -0,0 +1,104 @@
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func RoleDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: `Looks up an existing role by its ID or by its name.
+
+Use this data source to check that a role referenced by unkey_key exists.
+
+## Required Permissions
+
+Your root key needs:
+
+- rbac.*.read_role (read roles)`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: `The unique identifier of the role to look up.
+Exactly one of id and name must be set.`,
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 255),
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("id"),
+						path.MatchRoot("name"),
+					),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: `The unique name of the role to look up.
+Exactly one of id and name must be set.`,
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 512),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the role.",
+				Computed:    true,
+			},
+			"permissions": schema.ListAttribute{
+				Description: "The slugs of the permissions assigned to the role.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func RolesDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: `Lists the roles of the workspace.
+
+Every page of results is fetched, so the list is complete even for large role catalogs.
+
+## Required Permissions
+
+Your root key needs:
+
+- rbac.*.read_role (read roles)`,
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				Description: "Only list roles whose name begins with this value.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 512),
+				},
+			},
+			"roles": schema.ListNestedAttribute{
+				Description: "The roles that match the filters, in the order Unkey returns them.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique identifier of the role.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The unique name of the role.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the role.",
+							Computed:    true,
+						},
+						"permissions": schema.ListAttribute{
+							Description: "The slugs of the permissions assigned to the role.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
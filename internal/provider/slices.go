@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import "slices"
+
+// difference returns the values of a that are not in b, in the order of a.
+func difference(a, b []string) []string {
+	result := []string{}
+	for _, value := range a {
+		if !slices.Contains(b, value) {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+// intersection returns the values of a that are also in b, in the order of a.
+func intersection(a, b []string) []string {
+	result := []string{}
+	for _, value := range a {
+		if slices.Contains(b, value) {
+			result = append(result, value)
+		}
+	}
+	return result
+}
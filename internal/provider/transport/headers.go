@@ -0,0 +1,23 @@
+package transport
+
+import "net/http"
+
+// HeaderTransport adds the provider's User-Agent and any extra headers
+// configured by the practitioner to every Unkey API request.
+type HeaderTransport struct {
+	Base      http.RoundTripper
+	UserAgent string
+	Headers   map[string]string
+}
+
+func (t *HeaderTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the request it was given
+	req = req.Clone(req.Context())
+
+	for name, value := range t.Headers {
+		req.Header.Set(name, value)
+	}
+	req.Header.Set("User-Agent", t.UserAgent)
+
+	return t.Base.RoundTrip(req)
+}
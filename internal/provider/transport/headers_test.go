@@ -0,0 +1,89 @@
+package transport
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHeaderTransport(t *testing.T) {
+	var received http.Header
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Clone()
+	}))
+	t.Cleanup(server.Close)
+
+	tests := map[string]struct {
+		headers map[string]string
+		want    map[string]string
+	}{
+		"no custom headers": {
+			want: map[string]string{
+				"Authorization": "Bearer unkey_root",
+				"User-Agent":    "terraform-provider-unkey/1.0.0 terraform/1.10.0",
+			},
+		},
+		"custom headers": {
+			headers: map[string]string{
+				"X-Proxy-Token": "proxy_123",
+				"x-team":        "payments",
+			},
+			want: map[string]string{
+				"Authorization": "Bearer unkey_root",
+				"User-Agent":    "terraform-provider-unkey/1.0.0 terraform/1.10.0",
+				"X-Proxy-Token": "proxy_123",
+				"X-Team":        "payments",
+			},
+		},
+		// The provider rejects these names in custom_headers, and the
+		// User-Agent of the provider wins regardless
+		"custom User-Agent": {
+			headers: map[string]string{"User-Agent": "curl/8.0"},
+			want: map[string]string{
+				"Authorization": "Bearer unkey_root",
+				"User-Agent":    "terraform-provider-unkey/1.0.0 terraform/1.10.0",
+			},
+		},
+		"custom Authorization": {
+			headers: map[string]string{"Authorization": "Bearer other"},
+			want: map[string]string{
+				"Authorization": "Bearer other",
+				"User-Agent":    "terraform-provider-unkey/1.0.0 terraform/1.10.0",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			transport := &HeaderTransport{
+				Base:      http.DefaultTransport,
+				UserAgent: "terraform-provider-unkey/1.0.0 terraform/1.10.0",
+				Headers:   test.headers,
+			}
+
+			req, err := http.NewRequest(http.MethodPost, server.URL+"/v2/keys.getKey", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Set("Authorization", "Bearer unkey_root")
+			req.Header.Set("User-Agent", "speakeasy-sdk/go")
+
+			resp, err := transport.RoundTrip(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			for name, want := range test.want {
+				if got := received.Get(name); got != want {
+					t.Errorf("expected header %s to be %q, got %q", name, want, got)
+				}
+			}
+
+			if got := req.Header.Get("User-Agent"); got != "speakeasy-sdk/go" {
+				t.Errorf("expected the request of the caller not to be modified, got User-Agent %q", got)
+			}
+		})
+	}
+}
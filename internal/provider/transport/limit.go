@@ -0,0 +1,43 @@
+package transport
+
+import (
+	"net/http"
+
+	"golang.org/x/time/rate"
+)
+
+// LimitTransport throttles the Unkey API requests of the whole provider, so a
+// large apply stays within the workspace's quota however many resources
+// Terraform works on in parallel. A nil Limiter or Slots disables the
+// respective limit.
+type LimitTransport struct {
+	Base http.RoundTripper
+
+	// Limiter bounds the number of requests started per second.
+	Limiter *rate.Limiter
+
+	// Slots bounds the number of requests in flight, one buffered slot per
+	// request.
+	Slots chan struct{}
+}
+
+func (t *LimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	if t.Slots != nil {
+		select {
+		case t.Slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		defer func() { <-t.Slots }()
+	}
+
+	if t.Limiter != nil {
+		if err := t.Limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return t.Base.RoundTrip(req)
+}
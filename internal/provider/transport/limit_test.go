@@ -0,0 +1,141 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// roundTripFunc is a RoundTripper that calls the function.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func testLimitRequest(t *testing.T, ctx context.Context) *http.Request {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://api.unkey.com/v2/keys.getKey", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return req
+}
+
+func TestLimitTransportConcurrency(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+
+	transport := &LimitTransport{
+		Base: roundTripFunc(func(*http.Request) (*http.Response, error) {
+			current := inFlight.Add(1)
+			defer inFlight.Add(-1)
+
+			for {
+				seen := maxInFlight.Load()
+				if current <= seen || maxInFlight.CompareAndSwap(seen, current) {
+					break
+				}
+			}
+
+			time.Sleep(20 * time.Millisecond)
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+		}),
+		Slots: make(chan struct{}, 2),
+	}
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := transport.RoundTrip(testLimitRequest(t, context.Background())); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := maxInFlight.Load(); got != 2 {
+		t.Errorf("expected at most 2 requests in flight, got %d", got)
+	}
+	if got := len(transport.Slots); got != 0 {
+		t.Errorf("expected every slot to be released, got %d taken", got)
+	}
+}
+
+func TestLimitTransportRate(t *testing.T) {
+	var starts []time.Time
+
+	transport := &LimitTransport{
+		Base: roundTripFunc(func(*http.Request) (*http.Response, error) {
+			starts = append(starts, time.Now())
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+		}),
+		Limiter: rate.NewLimiter(rate.Limit(20), 1),
+	}
+
+	for range 5 {
+		if _, err := transport.RoundTrip(testLimitRequest(t, context.Background())); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// 20 requests per second start 50ms apart, with some slack for the
+	// timer resolution
+	for i := 1; i < len(starts); i++ {
+		if gap := starts[i].Sub(starts[i-1]); gap < 40*time.Millisecond {
+			t.Errorf("expected requests to start 50ms apart, request %d started after %s", i+1, gap)
+		}
+	}
+}
+
+func TestLimitTransportCancel(t *testing.T) {
+	release := make(chan struct{})
+
+	transport := &LimitTransport{
+		Base: roundTripFunc(func(*http.Request) (*http.Response, error) {
+			<-release
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+		}),
+		Slots:   make(chan struct{}, 1),
+		Limiter: rate.NewLimiter(rate.Every(time.Hour), 1),
+	}
+
+	// The first request takes the only slot and the only token
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if _, err := transport.RoundTrip(testLimitRequest(t, context.Background())); err != nil {
+			t.Error(err)
+		}
+	}()
+	for len(transport.Slots) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// A request waiting for a slot gives up when its context is canceled
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := transport.RoundTrip(testLimitRequest(t, ctx)); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected a request waiting for a slot to be canceled, got %v", err)
+	}
+
+	close(release)
+	<-done
+
+	// A request waiting for a token gives up too, and frees its slot
+	ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := transport.RoundTrip(testLimitRequest(t, ctx)); err == nil {
+		t.Error("expected a request waiting for the rate limit to be canceled")
+	}
+	if got := len(transport.Slots); got != 0 {
+		t.Errorf("expected a canceled request to release its slot, got %d taken", got)
+	}
+}
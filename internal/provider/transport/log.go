@@ -0,0 +1,128 @@
+package transport
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// LogSubsystem is the tflog subsystem of the Unkey API request logs. Its level
+// can be set separately through the TF_LOG_PROVIDER_UNKEY_HTTP environment
+// variable.
+const LogSubsystem = "unkey_http"
+
+// redacted replaces secret values in logged bodies.
+const redacted = "***"
+
+// secretFields are the JSON fields of Unkey request and response bodies that
+// hold key secrets: the key returned on creation and sent for verification,
+// and the plaintext of a decrypted key.
+var secretFields = map[string]bool{
+	"key":       true,
+	"plaintext": true,
+}
+
+// LogTransport logs every Unkey API request with its response and the request
+// ID Unkey assigned to it, which Unkey support asks for when investigating.
+// Secret fields of the bodies are redacted, and the Secrets are masked
+// wherever they appear.
+type LogTransport struct {
+	Base    http.RoundTripper
+	Secrets []string
+}
+
+func (t *LogTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := tflog.NewSubsystem(req.Context(), LogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_UNKEY_HTTP"))
+	ctx = tflog.SubsystemMaskAllFieldValuesStrings(ctx, LogSubsystem, t.Secrets...)
+	ctx = tflog.SubsystemMaskMessageStrings(ctx, LogSubsystem, t.Secrets...)
+
+	fields := map[string]any{
+		"method":    req.Method,
+		"path":      req.URL.Path,
+		"operation": path.Base(req.URL.Path),
+	}
+
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			content, _ := io.ReadAll(body)
+			body.Close()
+			fields["request_body"] = redactBody(content)
+		}
+	}
+
+	start := time.Now()
+	resp, err := t.Base.RoundTrip(req)
+	fields["latency"] = time.Since(start).String()
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemDebug(ctx, LogSubsystem, "Unkey API request failed", fields)
+		return resp, err
+	}
+
+	fields["status"] = resp.StatusCode
+
+	// Every response body, including errors, carries the request ID in its
+	// meta object. The body is read here and handed on unchanged.
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	var envelope struct {
+		Meta struct {
+			RequestID string `json:"requestId"`
+		} `json:"meta"`
+	}
+	if json.Unmarshal(body, &envelope) == nil && envelope.Meta.RequestID != "" {
+		fields["request_id"] = envelope.Meta.RequestID
+	}
+
+	fields["response_body"] = redactBody(body)
+
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Unkey API request", fields)
+
+	return resp, nil
+}
+
+// redactBody returns a JSON body for logging, with the values of secret
+// fields replaced at any depth. Bodies that are not JSON are returned as is.
+func redactBody(body []byte) string {
+	var value any
+	if json.Unmarshal(body, &value) != nil {
+		return string(body)
+	}
+
+	out, err := json.Marshal(redactValue(value))
+	if err != nil {
+		return string(body)
+	}
+
+	return string(out)
+}
+
+func redactValue(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		for name, field := range value {
+			if _, ok := field.(string); ok && secretFields[name] {
+				value[name] = redacted
+			} else {
+				value[name] = redactValue(field)
+			}
+		}
+	case []any:
+		for i, item := range value {
+			value[i] = redactValue(item)
+		}
+	}
+
+	return value
+}
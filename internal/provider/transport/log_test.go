@@ -0,0 +1,126 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestRedactBody(t *testing.T) {
+	tests := map[string]struct {
+		body string
+		want string
+	}{
+		"createKey response": {
+			body: `{"meta":{"requestId":"req_123"},"data":{"keyId":"key_123","key":"prod_3ZH8hqqDfJbDUoWXLrWBZkN9"}}`,
+			want: `{"data":{"key":"***","keyId":"key_123"},"meta":{"requestId":"req_123"}}`,
+		},
+		"rerollKey response": {
+			body: `{"meta":{"requestId":"req_456"},"data":{"keyId":"key_456","key":"prod_9pLkQ2mXcVbN7tRsYwE4uA1d"}}`,
+			want: `{"data":{"key":"***","keyId":"key_456"},"meta":{"requestId":"req_456"}}`,
+		},
+		"getKey request": {
+			body: `{"keyId":"key_123","decrypt":true}`,
+			want: `{"decrypt":true,"keyId":"key_123"}`,
+		},
+		"getKey response with plaintext": {
+			body: `{"meta":{"requestId":"req_789"},"data":{"keyId":"key_123","start":"prod_3ZH","plaintext":"prod_3ZH8hqqDfJbDUoWXLrWBZkN9","enabled":true}}`,
+			want: `{"data":{"enabled":true,"keyId":"key_123","plaintext":"***","start":"prod_3ZH"},"meta":{"requestId":"req_789"}}`,
+		},
+		"nested objects and arrays": {
+			body: `{"data":[{"keyId":"key_1","key":"secret_1"},{"keyId":"key_2","identity":{"key":"secret_2","meta":{"plaintext":"secret_3"}}}]}`,
+			want: `{"data":[{"key":"***","keyId":"key_1"},{"identity":{"key":"***","meta":{"plaintext":"***"}},"keyId":"key_2"}]}`,
+		},
+		// Only string values are secrets, other values are walked into
+		"secret field of another type": {
+			body: `{"key":{"id":"key_1","plaintext":"secret_1"},"plaintext":null}`,
+			want: `{"key":{"id":"key_1","plaintext":"***"},"plaintext":null}`,
+		},
+		"no secrets": {
+			body: `{"apiId":"api_123","limit":100}`,
+			want: `{"apiId":"api_123","limit":100}`,
+		},
+		"not JSON": {
+			body: `upstream connect error or disconnect/reset before headers`,
+			want: `upstream connect error or disconnect/reset before headers`,
+		},
+		"empty": {
+			body: ``,
+			want: ``,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := redactBody([]byte(test.body)); got != test.want {
+				t.Errorf("expected %s, got %s", test.want, got)
+			}
+		})
+	}
+}
+
+func TestLogTransport(t *testing.T) {
+	response := `{"meta":{"requestId":"req_123"},"data":{"keyId":"key_123","key":"prod_3ZH8hqqDfJbDUoWXLrWBZkN9"}}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, response)
+	}))
+	t.Cleanup(server.Close)
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	transport := &LogTransport{
+		Base:    http.DefaultTransport,
+		Secrets: []string{"unkey_root"},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/v2/keys.createKey", strings.NewReader(`{"apiId":"api_123","name":"unkey_root"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	// The response is handed on unchanged
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(body) != response {
+		t.Errorf("expected the response body %s, got %s", response, body)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("expected one log entry, got %v", entries)
+	}
+
+	want := map[string]any{
+		"@message":      "Unkey API request",
+		"operation":     "keys.createKey",
+		"request_id":    "req_123",
+		"request_body":  `{"apiId":"api_123","name":"***"}`,
+		"response_body": `{"data":{"key":"***","keyId":"key_123"},"meta":{"requestId":"req_123"}}`,
+		"status":        float64(http.StatusOK),
+	}
+	for name, value := range want {
+		if entries[0][name] != value {
+			t.Errorf("expected log field %s to be %v, got %v", name, value, entries[0][name])
+		}
+	}
+}
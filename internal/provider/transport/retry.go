@@ -0,0 +1,147 @@
+package transport
+
+import (
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// RetryTransport retries Unkey API requests that were rate limited or failed
+// with a transient server or connection error.
+//
+// Every Unkey operation is a POST, so whether a request is safe to repeat is
+// decided by the operation in its path. Rate limited requests were never
+// processed and are always retried. Server and connection errors leave it
+// unknown whether a create went through, so those are only retried for
+// operations that can be repeated without side effects.
+//
+// The wait before a retry doubles from MinBackoff up to MaxBackoff, and is
+// jittered so parallel operations that were rate limited together do not
+// retry together. A Retry-After header sent by Unkey takes precedence.
+type RetryTransport struct {
+	Base       http.RoundTripper
+	MaxRetries int
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	repeatable := isRepeatable(req)
+
+	// A body that cannot be read again cannot be sent again
+	rewindable := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+
+	for attempt := 0; ; attempt++ {
+		// Round trippers must not modify the request of the caller, so
+		// every retry sends a copy with a fresh body
+		attemptReq := req
+		if attempt > 0 {
+			attemptReq = req.Clone(ctx)
+			if req.GetBody != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, err
+				}
+				attemptReq.Body = body
+			}
+		}
+
+		resp, err := t.Base.RoundTrip(attemptReq)
+
+		retry := false
+		switch {
+		case err != nil:
+			retry = repeatable && ctx.Err() == nil
+		case resp.StatusCode == http.StatusTooManyRequests:
+			retry = true
+		case resp.StatusCode >= 500:
+			retry = repeatable
+		}
+
+		if !retry || !rewindable || attempt >= t.MaxRetries {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt)
+		if resp != nil {
+			if after, ok := retryAfter(resp); ok {
+				wait = after
+			}
+
+			// Drain the body so the connection can be reused
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		fields := map[string]any{
+			"operation": path.Base(req.URL.Path),
+			"attempt":   attempt + 1,
+			"wait":      wait.String(),
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status"] = resp.StatusCode
+		}
+		tflog.Warn(ctx, "Retrying Unkey API request", fields)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// minBackoff is the shortest wait before a retry, so a zero MinBackoff does
+// not retry in a tight loop.
+const minBackoff = 10 * time.Millisecond
+
+// backoff returns the exponential wait before the given retry attempt, with
+// a random part of up to half of it taken off.
+func (t *RetryTransport) backoff(attempt int) time.Duration {
+	wait := max(t.MinBackoff, minBackoff)
+	for i := 0; i < attempt && wait < t.MaxBackoff; i++ {
+		wait *= 2
+	}
+	wait = max(min(wait, t.MaxBackoff), minBackoff)
+
+	return wait - rand.N(wait/2+1)
+}
+
+// retryAfter parses the Retry-After header, given either in seconds or as an
+// HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
+
+// isRepeatable reports whether the Unkey operation of the request can be sent
+// again without side effects when its outcome is unknown. Creates and rerolls
+// generate new resources or secrets on every call, and a migration that went
+// through fails when it is sent again.
+func isRepeatable(req *http.Request) bool {
+	_, operation, _ := strings.Cut(path.Base(req.URL.Path), ".")
+	return !strings.HasPrefix(operation, "create") && operation != "rerollKey" && operation != "migrateKeys"
+}
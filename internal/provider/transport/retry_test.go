@@ -0,0 +1,235 @@
+package transport
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testRetryServer answers every request with the statuses in order, repeating
+// the last one, and counts the requests it received.
+func testRetryServer(t *testing.T, header http.Header, statuses ...int) (*httptest.Server, *atomic.Int32) {
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"keyId":"key_123"}` {
+			t.Errorf("expected the request body to be sent again, got %q", body)
+		}
+
+		n := int(requests.Add(1))
+		status := statuses[min(n, len(statuses))-1]
+		if status != http.StatusOK {
+			for name, values := range header {
+				w.Header()[name] = values
+			}
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &requests
+}
+
+func testRetryRequest(t *testing.T, server *httptest.Server, operation string) *http.Request {
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/v2/"+operation, strings.NewReader(`{"keyId":"key_123"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return req
+}
+
+func TestRetryTransport(t *testing.T) {
+	tests := map[string]struct {
+		operation    string
+		statuses     []int
+		wantStatus   int
+		wantRequests int32
+	}{
+		"rate limited create": {
+			operation:    "keys.createKey",
+			statuses:     []int{http.StatusTooManyRequests, http.StatusOK},
+			wantStatus:   http.StatusOK,
+			wantRequests: 2,
+		},
+		// The key may have been created, and a retry would create another
+		"server error on create": {
+			operation:    "keys.createKey",
+			statuses:     []int{http.StatusInternalServerError, http.StatusOK},
+			wantStatus:   http.StatusInternalServerError,
+			wantRequests: 1,
+		},
+		"server error on read": {
+			operation:    "keys.getKey",
+			statuses:     []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusOK},
+			wantStatus:   http.StatusOK,
+			wantRequests: 3,
+		},
+		"rate limited reroll": {
+			operation:    "keys.rerollKey",
+			statuses:     []int{http.StatusTooManyRequests, http.StatusOK},
+			wantStatus:   http.StatusOK,
+			wantRequests: 2,
+		},
+		// The key may have been rerolled, and a retry would reroll it again
+		"server error on reroll": {
+			operation:    "keys.rerollKey",
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusOK},
+			wantStatus:   http.StatusServiceUnavailable,
+			wantRequests: 1,
+		},
+		"client error": {
+			operation:    "keys.updateKey",
+			statuses:     []int{http.StatusBadRequest, http.StatusOK},
+			wantStatus:   http.StatusBadRequest,
+			wantRequests: 1,
+		},
+		"retries exhausted": {
+			operation:    "keys.getKey",
+			statuses:     []int{http.StatusTooManyRequests},
+			wantStatus:   http.StatusTooManyRequests,
+			wantRequests: 4,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server, requests := testRetryServer(t, nil, test.statuses...)
+
+			transport := &RetryTransport{
+				Base:       http.DefaultTransport,
+				MaxRetries: 3,
+				MinBackoff: time.Millisecond,
+				MaxBackoff: 10 * time.Millisecond,
+			}
+
+			req := testRetryRequest(t, server, test.operation)
+			body := req.Body
+
+			resp, err := transport.RoundTrip(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != test.wantStatus {
+				t.Errorf("expected status %d, got %d", test.wantStatus, resp.StatusCode)
+			}
+			if got := requests.Load(); got != test.wantRequests {
+				t.Errorf("expected %d requests, got %d", test.wantRequests, got)
+			}
+			if req.Body != body {
+				t.Errorf("expected the request of the caller not to be modified")
+			}
+		})
+	}
+}
+
+func TestRetryTransportRetryAfter(t *testing.T) {
+	server, requests := testRetryServer(t, http.Header{"Retry-After": {"1"}}, http.StatusTooManyRequests, http.StatusOK)
+
+	transport := &RetryTransport{
+		Base:       http.DefaultTransport,
+		MaxRetries: 3,
+		MinBackoff: time.Millisecond,
+		MaxBackoff: 10 * time.Millisecond,
+	}
+
+	start := time.Now()
+
+	resp, err := transport.RoundTrip(testRetryRequest(t, server, "keys.createKey"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("expected the retry to wait for the Retry-After header, waited %s", elapsed)
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("expected 2 requests, got %d", got)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := map[string]struct {
+		value  string
+		want   time.Duration
+		wantOk bool
+	}{
+		"missing":  {value: "", wantOk: false},
+		"seconds":  {value: "3", want: 3 * time.Second, wantOk: true},
+		"past":     {value: "Mon, 02 Jan 2006 15:04:05 GMT", want: 0, wantOk: true},
+		"negative": {value: "-1", wantOk: false},
+		"invalid":  {value: "soon", wantOk: false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			if test.value != "" {
+				resp.Header.Set("Retry-After", test.value)
+			}
+
+			got, ok := retryAfter(resp)
+			if ok != test.wantOk || got != test.want {
+				t.Errorf("expected %s %t, got %s %t", test.want, test.wantOk, got, ok)
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	transport := &RetryTransport{
+		MinBackoff: time.Second,
+		MaxBackoff: 10 * time.Second,
+	}
+
+	for attempt, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second} {
+		for range 100 {
+			got := transport.backoff(attempt)
+			if got < want/2 || got > want {
+				t.Fatalf("expected the wait before retry %d to be between %s and %s, got %s", attempt+1, want/2, want, got)
+			}
+		}
+	}
+
+	// A zero minimum still waits before retrying
+	transport.MinBackoff = 0
+	if got := transport.backoff(0); got <= 0 {
+		t.Errorf("expected a zero minimum backoff to still wait, got %s", got)
+	}
+}
+
+func TestIsRepeatable(t *testing.T) {
+	tests := map[string]bool{
+		"apis.createAPI":               false,
+		"keys.createKey":               false,
+		"keys.rerollKey":               false,
+		"keys.migrateKeys":             false,
+		"permissions.createPermission": false,
+		"keys.getKey":                  true,
+		"keys.updateKey":               true,
+		"keys.deleteKey":               true,
+		"permissions.listPermissions":  true,
+		"identities.updateIdentity":    true,
+	}
+
+	for operation, want := range tests {
+		t.Run(operation, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPost, "https://api.unkey.com/v2/"+operation, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := isRepeatable(req); got != want {
+				t.Errorf("expected %t, got %t", want, got)
+			}
+		})
+	}
+}